@@ -1,8 +1,69 @@
 package ferrite
 
+import "github.com/dogmatiq/ferrite/internal/variable"
+
 // isBuilderOf makes a static assertion that B meats
 type isBuilderOf[T any, B interface {
 	Required(options ...RequiredOption) Required[T]
 	Optional(options ...OptionalOption) Optional[T]
 	Deprecated(options ...DeprecatedOption) Deprecated[T]
 }] struct{}
+
+// Element is a builder that describes the individual elements of a composite
+// variable, such as a slice or map.
+//
+// It is implemented by the builder types that produce a value from a single
+// environment variable, such as [StringBuilder] and [URLBuilder].
+type Element[T any] interface {
+	element() (variable.TypedSchema[T], *variable.TypedSpecBuilder[T])
+}
+
+// buildElement completes the build process of an element builder, using the
+// name and description of the composite variable that contains it.
+func buildElement[T any](name, desc string, elem Element[T]) *variable.TypedSpec[T] {
+	schema, builder := elem.element()
+	builder.Name(name)
+	builder.Description(desc)
+	return builder.Done(schema)
+}
+
+// inheritElement configures a composite variable's builder to inherit the
+// sensitivity and documentation of one of its elements.
+func inheritElement[T any](b *variable.TypedSpecBuilder[T], elem variable.Spec) {
+	if elem.IsSensitive() {
+		b.MarkSensitive()
+	}
+
+	for _, d := range elem.Documentation() {
+		doc := b.Documentation().Summary(d.Summary)
+
+		for _, p := range d.Paragraphs {
+			doc = doc.Paragraph("%s").Format(p)
+		}
+
+		if d.IsImportant {
+			doc = doc.Important()
+		}
+
+		doc.Done()
+	}
+}
+
+// addExamples adds examples to b, skipping any that do not meet the
+// constraints that have been added to b so far.
+func addExamples[T any](
+	b *variable.TypedSpecBuilder[T],
+	examples ...variable.TypedExample[T],
+) {
+	for _, eg := range examples {
+		if b.CheckConstraints(eg.Native) != nil {
+			continue
+		}
+
+		if eg.IsNormative {
+			b.NormativeExample(eg.Native, eg.Description)
+		} else {
+			b.NonNormativeExample(eg.Native, eg.Description)
+		}
+	}
+}
@@ -0,0 +1,245 @@
+package ferrite
+
+import (
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/dogmatiq/ferrite/internal/maybe"
+	"github.com/dogmatiq/ferrite/internal/variable"
+)
+
+// NetworkAddress is a network address consisting of a host and a port.
+type NetworkAddress struct {
+	// Host is the hostname or IP address. It may be empty, in which case the
+	// address refers to all available local interfaces.
+	Host string
+
+	// Port is the numeric port or IANA service name.
+	Port string
+}
+
+// String returns the address in "host:port" form, suitable for use with
+// [net.Listen] and [net.Dial].
+func (a NetworkAddress) String() string {
+	return net.JoinHostPort(a.Host, a.Port)
+}
+
+// Address configures an environment variable as a network address consisting
+// of a host and a port, such as "0.0.0.0:8080".
+//
+// The host may be omitted, such as ":8080". The port may be a numeric value
+// between 1 and 65535, or an IANA registered service name (such as "https").
+//
+// name is the name of the environment variable to read. desc is a
+// human-readable description of the environment variable.
+func Address(name, desc string) *AddressBuilder {
+	b := &AddressBuilder{
+		examples: []variable.TypedExample[NetworkAddress]{
+			{
+				Native:      NetworkAddress{"localhost", "8080"},
+				Description: "a hostname and numeric port",
+			},
+			{
+				Native:      NetworkAddress{"0.0.0.0", "https"},
+				Description: "all IPv4 interfaces, using the IANA service name that maps to port 443",
+			},
+		},
+	}
+	b.schema.Marshaler = b.marshaler
+	b.schema.Check = b.check
+
+	b.builder.Name(name)
+	b.builder.Description(desc)
+	b.builder.BuiltInConstraint(
+		"**MUST** be a valid network address",
+		func(v NetworkAddress) variable.ConstraintError {
+			if v.Host != "" {
+				if err := validateHost(v.Host); err != nil {
+					return err
+				}
+			}
+
+			return validatePort(v.Port)
+		},
+	)
+	b.builder.Documentation().
+		Summary("Network address syntax").
+		Paragraph(
+			"Addresses are specified as a host and port separated by a colon, such as `localhost:8080`.",
+			"IPv6 addresses must be enclosed in square brackets, such as `[::1]:8080`.",
+			"The host may be omitted, such as `:8080`.",
+		).
+		Format().
+		Done()
+	buildNetworkPortSyntaxDocumentation(b.builder.Documentation())
+
+	return b
+}
+
+// AddressBuilder builds a specification for a network address variable.
+type AddressBuilder struct {
+	schema    variable.TypedOther[NetworkAddress]
+	builder   variable.TypedSpecBuilder[NetworkAddress]
+	marshaler addressMarshaler
+	host      maybe.Value[string]
+	def       maybe.Value[string]
+	examples  []variable.TypedExample[NetworkAddress]
+	built     bool
+}
+
+var _ isBuilderOf[NetworkAddress, *AddressBuilder]
+
+// WithDefault sets a default value of the variable.
+//
+// It is used when the environment variable is undefined or empty. If v does not
+// include a host, the host configured by [AddressBuilder.WithDefaultHost] is
+// used.
+func (b *AddressBuilder) WithDefault(v string) *AddressBuilder {
+	b.def = maybe.Some(v)
+	b.buildDefault()
+	return b
+}
+
+// WithDefaultHost sets the host to use when the environment variable's value
+// does not include one, such as ":8080".
+func (b *AddressBuilder) WithDefaultHost(host string) *AddressBuilder {
+	b.host = maybe.Some(host)
+	b.marshaler.DefaultHost = host
+	b.schema.Marshaler = b.marshaler
+	b.buildDefault()
+
+	return b
+}
+
+// WithConstraint adds a constraint to the variable.
+//
+// fn is called with the parsed network address. If it returns false the value
+// is considered invalid.
+func (b *AddressBuilder) WithConstraint(
+	desc string,
+	fn func(NetworkAddress) bool,
+) *AddressBuilder {
+	b.builder.UserConstraint(desc, fn)
+	return b
+}
+
+// WithConstraintFunc adds a constraint to the variable.
+//
+// It is like [AddressBuilder.WithConstraint], except that fn returns an error
+// that explains why the address is invalid, or nil if it is valid.
+func (b *AddressBuilder) WithConstraintFunc(
+	desc string,
+	fn func(NetworkAddress) error,
+) *AddressBuilder {
+	b.builder.UserConstraintFunc(desc, fn)
+	return b
+}
+
+// Required completes the build process and registers a required variable with
+// Ferrite's validation system.
+func (b *AddressBuilder) Required(options ...RequiredOption) Required[NetworkAddress] {
+	b.build()
+	return required(b.schema, &b.builder, options...)
+}
+
+// Optional completes the build process and registers an optional variable with
+// Ferrite's validation system.
+func (b *AddressBuilder) Optional(options ...OptionalOption) Optional[NetworkAddress] {
+	b.build()
+	return optional(b.schema, &b.builder, options...)
+}
+
+// Deprecated completes the build process and registers a deprecated variable
+// with Ferrite's validation system.
+func (b *AddressBuilder) Deprecated(options ...DeprecatedOption) Deprecated[NetworkAddress] {
+	b.build()
+	return deprecated(b.schema, &b.builder, options...)
+}
+
+func (b *AddressBuilder) element() (variable.TypedSchema[NetworkAddress], *variable.TypedSpecBuilder[NetworkAddress]) {
+	b.build()
+	return b.schema, &b.builder
+}
+
+// build adds the documentation for the default host and the examples that
+// meet the variable's constraints, which may be configured in any order.
+func (b *AddressBuilder) build() {
+	if !b.built {
+		b.built = true
+
+		if host, ok := b.host.Get(); ok {
+			b.builder.Documentation().
+				Paragraph("If the host is omitted, `%s` is used.").
+				Format(host).
+				Important().
+				Done()
+		}
+
+		addExamples(&b.builder, b.examples...)
+	}
+}
+
+// buildDefault sets the variable's default value, such that it includes the
+// default host regardless of the order in which the options are applied.
+func (b *AddressBuilder) buildDefault() {
+	if v, ok := b.def.Get(); ok {
+		if addr, err := b.marshaler.Unmarshal(variable.Literal{String: v}); err == nil {
+			b.builder.Default(addr)
+		}
+	}
+}
+
+// check returns an error if the builder's configuration is invalid.
+func (b *AddressBuilder) check() error {
+	if host, ok := b.host.Get(); ok {
+		if err := validateHost(host); err != nil {
+			return fmt.Errorf("default host: %w", err)
+		}
+	}
+
+	if v, ok := b.def.Get(); ok {
+		if _, err := b.marshaler.Unmarshal(variable.Literal{String: v}); err != nil {
+			return fmt.Errorf("default value: %w", err)
+		}
+	}
+
+	return nil
+}
+
+type addressMarshaler struct {
+	DefaultHost string
+}
+
+func (m addressMarshaler) Marshal(v NetworkAddress) (variable.Literal, error) {
+	if v == (NetworkAddress{}) {
+		// The zero value is represented as an empty literal, such as when
+		// describing the conditions of other variables that depend on this one.
+		return variable.Literal{}, nil
+	}
+
+	if v.Host == "" {
+		v.Host = m.DefaultHost
+	}
+
+	return variable.Literal{
+		String: v.String(),
+	}, nil
+}
+
+func (m addressMarshaler) Unmarshal(v variable.Literal) (NetworkAddress, error) {
+	host, port, err := net.SplitHostPort(v.String)
+	if err != nil {
+		var addrErr *net.AddrError
+		if errors.As(err, &addrErr) {
+			return NetworkAddress{}, errors.New(addrErr.Err)
+		}
+		return NetworkAddress{}, err
+	}
+
+	if host == "" {
+		host = m.DefaultHost
+	}
+
+	return NetworkAddress{host, port}, nil
+}
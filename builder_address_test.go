@@ -0,0 +1,302 @@
+package ferrite_test
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("type NetworkAddress", func() {
+	Describe("func String()", func() {
+		DescribeTable(
+			"it returns the address in host:port form",
+			func(addr NetworkAddress, expect string) {
+				Expect(addr.String()).To(Equal(expect))
+			},
+			Entry("hostname", NetworkAddress{"localhost", "8080"}, "localhost:8080"),
+			Entry("IPv6 address", NetworkAddress{"::1", "8080"}, "[::1]:8080"),
+			Entry("empty host", NetworkAddress{"", "8080"}, ":8080"),
+		)
+	})
+})
+
+var _ = Describe("type AddressBuilder", func() {
+	var builder *AddressBuilder
+
+	BeforeEach(func() {
+		builder = Address("FERRITE_ADDRESS", "<desc>")
+	})
+
+	AfterEach(func() {
+		tearDown()
+	})
+
+	It("panics if the name is empty", func() {
+		Expect(func() {
+			Address("", "<desc>").Optional()
+		}).To(PanicWith("invalid specification: variable name must not be empty"))
+	})
+
+	It("panics if the description is empty", func() {
+		Expect(func() {
+			Address("FERRITE_ADDRESS", "").Optional()
+		}).To(PanicWith("specification for FERRITE_ADDRESS is invalid: variable description must not be empty"))
+	})
+
+	It("panics if the default host is invalid", func() {
+		Expect(func() {
+			builder.
+				WithDefaultHost(".local").
+				Optional()
+		}).To(PanicWith("specification for FERRITE_ADDRESS is invalid: default host: host must not begin or end with a dot"))
+	})
+
+	It("panics if the default value is invalid", func() {
+		Expect(func() {
+			builder.
+				WithDefault("localhost").
+				Optional()
+		}).To(PanicWith("specification for FERRITE_ADDRESS is invalid: default value: missing port in address"))
+	})
+
+	When("the variable is required", func() {
+		When("the value is valid", func() {
+			Describe("func Value()", func() {
+				DescribeTable(
+					"it returns the value",
+					func(value string, expect NetworkAddress) {
+						os.Setenv("FERRITE_ADDRESS", value)
+
+						v := builder.
+							Required().
+							Value()
+
+						Expect(v).To(Equal(expect))
+					},
+					Entry("hostname", "localhost:8080", NetworkAddress{"localhost", "8080"}),
+					Entry("IPv4 address", "0.0.0.0:8080", NetworkAddress{"0.0.0.0", "8080"}),
+					Entry("IPv6 address", "[::1]:8080", NetworkAddress{"::1", "8080"}),
+					Entry("empty host", ":8080", NetworkAddress{"", "8080"}),
+					Entry("IANA service name", "localhost:https", NetworkAddress{"localhost", "https"}),
+				)
+
+				It("uses the default host if the value does not include a host", func() {
+					os.Setenv("FERRITE_ADDRESS", ":8080")
+
+					v := builder.
+						WithDefaultHost("127.0.0.1").
+						Required().
+						Value()
+
+					Expect(v).To(Equal(NetworkAddress{"127.0.0.1", "8080"}))
+				})
+			})
+		})
+
+		When("the value is invalid", func() {
+			Describe("func Value()", func() {
+				DescribeTable(
+					"it panics",
+					func(value, expect string) {
+						os.Setenv("FERRITE_ADDRESS", value)
+
+						Expect(func() {
+							builder.
+								Required().
+								Value()
+						}).To(PanicWith(expect))
+					},
+					Entry(
+						"missing port",
+						"localhost",
+						`value of FERRITE_ADDRESS (localhost) is invalid: missing port in address`,
+					),
+					Entry(
+						"empty port",
+						"localhost:",
+						`value of FERRITE_ADDRESS (localhost:) is invalid: port must not be empty`,
+					),
+					Entry(
+						"numeric port out of range",
+						"localhost:65536",
+						`value of FERRITE_ADDRESS (localhost:65536) is invalid: numeric ports must be between 1 and 65535`,
+					),
+					Entry(
+						"invalid host",
+						".local:8080",
+						`value of FERRITE_ADDRESS (.local:8080) is invalid: host must not begin or end with a dot`,
+					),
+					Entry(
+						"unbracketed IPv6 address",
+						"::1:8080",
+						`value of FERRITE_ADDRESS (::1:8080) is invalid: too many colons in address`,
+					),
+				)
+			})
+		})
+
+		When("the value is empty", func() {
+			When("there is a default value", func() {
+				Describe("func Value()", func() {
+					It("returns the default", func() {
+						v := builder.
+							WithDefault("localhost:8080").
+							Required().
+							Value()
+
+						Expect(v).To(Equal(NetworkAddress{"localhost", "8080"}))
+					})
+
+					It("uses the default host if the default value does not include a host", func() {
+						v := builder.
+							WithDefaultHost("127.0.0.1").
+							WithDefault(":8080").
+							Required().
+							Value()
+
+						Expect(v).To(Equal(NetworkAddress{"127.0.0.1", "8080"}))
+					})
+
+					It("uses the default host if it is set after the default value", func() {
+						v := builder.
+							WithDefault(":8080").
+							WithDefaultHost("127.0.0.1").
+							Required().
+							Value()
+
+						Expect(v).To(Equal(NetworkAddress{"127.0.0.1", "8080"}))
+					})
+				})
+			})
+
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("panics", func() {
+						Expect(func() {
+							builder.
+								Required().
+								Value()
+						}).To(PanicWith(
+							"FERRITE_ADDRESS is undefined and does not have a default value",
+						))
+					})
+				})
+			})
+		})
+	})
+
+	When("the variable is optional", func() {
+		When("the value is empty", func() {
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("returns with ok == false", func() {
+						_, ok := builder.
+							Optional().
+							Value()
+
+						Expect(ok).To(BeFalse())
+					})
+				})
+			})
+		})
+	})
+})
+
+func ExampleAddress_required() {
+	defer example()()
+
+	v := ferrite.
+		Address("FERRITE_ADDRESS", "example address variable").
+		Required()
+
+	os.Setenv("FERRITE_ADDRESS", "localhost:8080")
+	ferrite.Init()
+
+	fmt.Println("host is", v.Value().Host)
+	fmt.Println("port is", v.Value().Port)
+
+	// Output:
+	// host is localhost
+	// port is 8080
+}
+
+func ExampleAddress_default() {
+	defer example()()
+
+	v := ferrite.
+		Address("FERRITE_ADDRESS", "example address variable").
+		WithDefault(":8080").
+		Required()
+
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is :8080
+}
+
+func ExampleAddress_defaultHost() {
+	defer example()()
+
+	v := ferrite.
+		Address("FERRITE_ADDRESS", "example address variable").
+		WithDefaultHost("127.0.0.1").
+		Required()
+
+	os.Setenv("FERRITE_ADDRESS", ":https")
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is 127.0.0.1:https
+}
+
+func ExampleAddress_optional() {
+	defer example()()
+
+	v := ferrite.
+		Address("FERRITE_ADDRESS", "example address variable").
+		Optional()
+
+	ferrite.Init()
+
+	if x, ok := v.Value(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// value is undefined
+}
+
+func ExampleAddress_deprecated() {
+	defer example()()
+
+	os.Setenv("FERRITE_ADDRESS", ":8080")
+	v := ferrite.
+		Address("FERRITE_ADDRESS", "example address variable").
+		WithDefaultHost("0.0.0.0").
+		Deprecated()
+
+	ferrite.Init()
+
+	if x, ok := v.DeprecatedValue(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// Environment Variables:
+	//
+	//  ❯ FERRITE_ADDRESS  example address variable  [ <string> ]  ⚠ deprecated variable set to :8080, equivalent to 0.0.0.0:8080
+	//
+	// value is 0.0.0.0:8080
+}
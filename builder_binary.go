@@ -4,6 +4,7 @@ import (
 	"encoding/base64"
 	"encoding/hex"
 
+	"github.com/dogmatiq/ferrite/internal/maybe"
 	"github.com/dogmatiq/ferrite/internal/variable"
 )
 
@@ -80,6 +81,31 @@ func (b *BinaryBuilder[T, B]) WithEncodedDefault(v string) *BinaryBuilder[T, B]
 	return b.WithDefault(enc)
 }
 
+// WithMinimumLength sets the minimum length of the variable's value, in bytes.
+//
+// The length is that of the raw binary value, not its encoded representation.
+func (b *BinaryBuilder[T, B]) WithMinimumLength(n int) *BinaryBuilder[T, B] {
+	b.schema.MinLen = maybe.Some(n)
+	return b
+}
+
+// WithMaximumLength sets the maximum length of the variable's value, in bytes.
+//
+// The length is that of the raw binary value, not its encoded representation.
+func (b *BinaryBuilder[T, B]) WithMaximumLength(n int) *BinaryBuilder[T, B] {
+	b.schema.MaxLen = maybe.Some(n)
+	return b
+}
+
+// WithLength sets the exact length of the variable's value, in bytes.
+//
+// The length is that of the raw binary value, not its encoded representation.
+func (b *BinaryBuilder[T, B]) WithLength(n int) *BinaryBuilder[T, B] {
+	b.schema.MinLen = maybe.Some(n)
+	b.schema.MaxLen = maybe.Some(n)
+	return b
+}
+
 // WithConstraint adds a constraint to the variable.
 //
 // fn is called with the environment variable value after it is parsed. If fn
@@ -92,6 +118,18 @@ func (b *BinaryBuilder[T, B]) WithConstraint(
 	return b
 }
 
+// WithConstraintFunc adds a constraint to the variable.
+//
+// It is like [BinaryBuilder.WithConstraint], except that fn returns an error
+// that explains why the data is invalid, or nil if it is valid.
+func (b *BinaryBuilder[T, B]) WithConstraintFunc(
+	desc string,
+	fn func(T) error,
+) *BinaryBuilder[T, B] {
+	b.builder.UserConstraintFunc(desc, fn)
+	return b
+}
+
 // WithSensitiveContent marks the variable as containing sensitive content.
 //
 // Values of sensitive variables are not printed to the console or included in
@@ -150,6 +188,10 @@ func (b *BinaryBuilder[T, B]) Deprecated(options ...DeprecatedOption) Deprecated
 	return deprecated(b.schema, &b.builder, options...)
 }
 
+func (b *BinaryBuilder[T, B]) element() (variable.TypedSchema[T], *variable.TypedSpecBuilder[T]) {
+	return b.schema, &b.builder
+}
+
 type base64BinaryMarshaler[T ~[]B, B ~byte] struct {
 	Encoding *base64.Encoding
 }
@@ -37,6 +37,72 @@ var _ = Describe("type BinaryBuilder", func() {
 		}).To(PanicWith("specification for FERRITE_BINARY is invalid: variable description must not be empty"))
 	})
 
+	It("panics if the minimum length is less than one", func() {
+		Expect(func() {
+			builder.
+				WithMinimumLength(0).
+				Optional()
+		}).To(PanicWith("specification for FERRITE_BINARY is invalid: minimum length: must be at least 1"))
+	})
+
+	It("panics if the maximum length is less than the minimum length", func() {
+		Expect(func() {
+			builder.
+				WithMinimumLength(10).
+				WithMaximumLength(5).
+				Optional()
+		}).To(PanicWith("specification for FERRITE_BINARY is invalid: maximum length: must be at least 10"))
+	})
+
+	When("the variable has length limits", func() {
+		Describe("func Value()", func() {
+			It("returns the value if it is within the limits", func() {
+				os.Setenv("FERRITE_BINARY", "YWJjZGVmZw==")
+
+				v := builder.
+					WithMinimumLength(5).
+					WithMaximumLength(10).
+					Required().
+					Value()
+
+				Expect(v).To(Equal(userDefinedBinary("abcdefg")))
+			})
+
+			DescribeTable(
+				"it panics if the value is outside the limits",
+				func(configure func(*BinaryBuilder[userDefinedBinary, userDefinedByte]), value, expect string) {
+					os.Setenv("FERRITE_BINARY", value)
+
+					configure(builder)
+
+					Expect(func() {
+						builder.
+							Required().
+							Value()
+					}).To(PanicWith(expect))
+				},
+				Entry(
+					"too short",
+					func(b *BinaryBuilder[userDefinedBinary, userDefinedByte]) { b.WithMinimumLength(5) },
+					"YWJjZA==",
+					`value of FERRITE_BINARY (YWJjZA==) is invalid: too short, expected length to be 5 bytes or more`,
+				),
+				Entry(
+					"too long",
+					func(b *BinaryBuilder[userDefinedBinary, userDefinedByte]) { b.WithMaximumLength(5) },
+					"YWJjZGVmZ2g=",
+					`value of FERRITE_BINARY (YWJjZGVmZ2g=) is invalid: too long, expected length to be 5 bytes or fewer`,
+				),
+				Entry(
+					"not the exact length",
+					func(b *BinaryBuilder[userDefinedBinary, userDefinedByte]) { b.WithLength(5) },
+					"YWJjZGVmZ2g=",
+					`value of FERRITE_BINARY (YWJjZGVmZ2g=) is invalid: too long, expected length to be exactly 5 bytes`,
+				),
+			)
+		})
+	})
+
 	When("the variable is required", func() {
 		When("the value is not empty", func() {
 			Describe("func Value()", func() {
@@ -258,6 +324,24 @@ func ExampleBinary_hex() {
 	// value is <value>
 }
 
+func ExampleBinary_length() {
+	defer example()()
+
+	v := ferrite.
+		Binary("FERRITE_BINARY", "example binary variable").
+		WithHexEncoding().
+		WithLength(4).
+		Required()
+
+	os.Setenv("FERRITE_BINARY", "deadbeef")
+	ferrite.Init()
+
+	fmt.Printf("value is %x\n", v.Value())
+
+	// Output:
+	// value is deadbeef
+}
+
 func ExampleBinary_deprecated() {
 	defer example()()
 
@@ -69,6 +69,30 @@ func (b *BoolBuilder[T]) WithDefault(v T) *BoolBuilder[T] {
 	return b
 }
 
+// WithConstraint adds a constraint to the variable.
+//
+// fn is called with the parsed boolean value. If it returns false the value is
+// considered invalid.
+func (b *BoolBuilder[T]) WithConstraint(
+	desc string,
+	fn func(T) bool,
+) *BoolBuilder[T] {
+	b.builder.UserConstraint(desc, fn)
+	return b
+}
+
+// WithConstraintFunc adds a constraint to the variable.
+//
+// It is like [BoolBuilder.WithConstraint], except that fn returns an error that
+// explains why the value is invalid, or nil if it is valid.
+func (b *BoolBuilder[T]) WithConstraintFunc(
+	desc string,
+	fn func(T) error,
+) *BoolBuilder[T] {
+	b.builder.UserConstraintFunc(desc, fn)
+	return b
+}
+
 // Required completes the build process and registers a required variable with
 // Ferrite's validation system.
 func (b *BoolBuilder[T]) Required(options ...RequiredOption) Required[T] {
@@ -86,3 +110,7 @@ func (b *BoolBuilder[T]) Optional(options ...OptionalOption) Optional[T] {
 func (b *BoolBuilder[T]) Deprecated(options ...DeprecatedOption) Deprecated[T] {
 	return deprecated(b.schema, &b.builder, options...)
 }
+
+func (b *BoolBuilder[T]) element() (variable.TypedSchema[T], *variable.TypedSpecBuilder[T]) {
+	return b.schema, &b.builder
+}
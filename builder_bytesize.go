@@ -0,0 +1,300 @@
+package ferrite
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/dogmatiq/ferrite/internal/maybe"
+	"github.com/dogmatiq/ferrite/internal/variable"
+)
+
+// ByteSize configures an environment variable as a number of bytes.
+//
+// The value may be specified as a plain number of bytes, such as "4096", or
+// using SI (decimal) or IEC (binary) units, such as "1.5GB" or "512MiB".
+//
+// name is the name of the environment variable to read. desc is a
+// human-readable description of the environment variable.
+func ByteSize(name, desc string) *ByteSizeBuilder {
+	b := &ByteSizeBuilder{}
+	b.schema.Check = func() error {
+		return b.limits().Check()
+	}
+
+	b.builder.Name(name)
+	b.builder.Description(desc)
+	b.builder.Documentation().
+		Summary("Byte size syntax").
+		Paragraph(
+			"Sizes are specified as a non-negative number with an **OPTIONAL** unit suffix, such as `4096`, `512MiB` or `1.5GB`.",
+			"A number without a unit is interpreted as a number of bytes.",
+		).
+		Format().
+		Paragraph(
+			"The supported units are `B` (bytes);",
+			"the SI units `KB`, `MB`, `GB`, `TB`, `PB` and `EB`, which are powers of 1000;",
+			"and the IEC units `KiB`, `MiB`, `GiB`, `TiB`, `PiB` and `EiB`, which are powers of 1024.",
+			"Units are not case-sensitive.",
+		).
+		Format().
+		Done()
+
+	return b
+}
+
+// ByteSizeBuilder builds a specification for a byte size variable.
+type ByteSizeBuilder struct {
+	schema    variable.TypedOther[uint64]
+	builder   variable.TypedSpecBuilder[uint64]
+	marshaler byteSizeMarshaler
+	min, max  maybe.Value[uint64]
+	built     bool
+}
+
+var _ isBuilderOf[uint64, *ByteSizeBuilder]
+
+// WithDefault sets a default value of the variable, in bytes.
+//
+// It is used when the environment variable is undefined or empty.
+func (b *ByteSizeBuilder) WithDefault(v uint64) *ByteSizeBuilder {
+	b.builder.Default(v)
+	return b
+}
+
+// WithMinimum sets the minimum acceptable value of the variable, in bytes.
+func (b *ByteSizeBuilder) WithMinimum(v uint64) *ByteSizeBuilder {
+	b.min = maybe.Some(v)
+	return b
+}
+
+// WithMaximum sets the maximum acceptable value of the variable, in bytes.
+func (b *ByteSizeBuilder) WithMaximum(v uint64) *ByteSizeBuilder {
+	b.max = maybe.Some(v)
+	return b
+}
+
+// WithSIUnits uses SI (decimal) units, such as "MB", when formatting the
+// canonical representation of the variable's value.
+//
+// By default, IEC (binary) units such as "MiB" are used. Both kinds of unit
+// are always accepted as input.
+func (b *ByteSizeBuilder) WithSIUnits() *ByteSizeBuilder {
+	b.marshaler.SI = true
+	return b
+}
+
+// WithConstraint adds a constraint to the variable.
+//
+// fn is called with the parsed size, in bytes. If it returns false the value is
+// considered invalid.
+func (b *ByteSizeBuilder) WithConstraint(
+	desc string,
+	fn func(uint64) bool,
+) *ByteSizeBuilder {
+	b.builder.UserConstraint(desc, fn)
+	return b
+}
+
+// WithConstraintFunc adds a constraint to the variable.
+//
+// It is like [ByteSizeBuilder.WithConstraint], except that fn returns an error
+// that explains why the size is invalid, or nil if it is valid.
+func (b *ByteSizeBuilder) WithConstraintFunc(
+	desc string,
+	fn func(uint64) error,
+) *ByteSizeBuilder {
+	b.builder.UserConstraintFunc(desc, fn)
+	return b
+}
+
+// Required completes the build process and registers a required variable with
+// Ferrite's validation system.
+func (b *ByteSizeBuilder) Required(options ...RequiredOption) Required[uint64] {
+	b.build()
+	return required(b.schema, &b.builder, options...)
+}
+
+// Optional completes the build process and registers an optional variable with
+// Ferrite's validation system.
+func (b *ByteSizeBuilder) Optional(options ...OptionalOption) Optional[uint64] {
+	b.build()
+	return optional(b.schema, &b.builder, options...)
+}
+
+// Deprecated completes the build process and registers a deprecated variable
+// with Ferrite's validation system.
+func (b *ByteSizeBuilder) Deprecated(options ...DeprecatedOption) Deprecated[uint64] {
+	b.build()
+	return deprecated(b.schema, &b.builder, options...)
+}
+
+func (b *ByteSizeBuilder) element() (variable.TypedSchema[uint64], *variable.TypedSpecBuilder[uint64]) {
+	b.build()
+	return b.schema, &b.builder
+}
+
+// build adds the constraints and examples that depend on the limits and units
+// of the variable, which may be configured in any order.
+func (b *ByteSizeBuilder) build() {
+	if b.built {
+		return
+	}
+	b.built = true
+
+	b.schema.Marshaler = b.marshaler
+	b.limits().Constrain(&b.builder)
+
+	if min, ok := b.min.Get(); ok {
+		addExamples(&b.builder, variable.TypedExample[uint64]{
+			Native:      min,
+			Description: "the minimum accepted value",
+		})
+	}
+
+	if max, ok := b.max.Get(); ok {
+		addExamples(&b.builder, variable.TypedExample[uint64]{
+			Native:      max,
+			Description: "the maximum accepted value",
+		})
+	}
+
+	// Add some typical sizes, only those within the limits are used.
+	units := b.marshaler.units()
+	addExamples(
+		&b.builder,
+		variable.TypedExample[uint64]{Native: 512 * units[2].Size},
+		variable.TypedExample[uint64]{Native: units[3].Size},
+	)
+}
+
+// limits returns the limits of the variable's value.
+func (b *ByteSizeBuilder) limits() rangeLimits[uint64] {
+	return rangeLimits[uint64]{
+		Min: b.min,
+		Max: b.max,
+		Less: func(a, b uint64) bool {
+			return a < b
+		},
+		Format:  b.marshaler.format,
+		Lower:   "less",
+		Higher:  "greater",
+		TooLow:  "low",
+		TooHigh: "high",
+	}
+}
+
+type byteSizeUnit struct {
+	Symbol string
+	Size   uint64
+}
+
+var (
+	// siByteSizeUnits is the set of SI (decimal) units, in ascending order.
+	siByteSizeUnits = []byteSizeUnit{
+		{"B", 1},
+		{"KB", 1e3},
+		{"MB", 1e6},
+		{"GB", 1e9},
+		{"TB", 1e12},
+		{"PB", 1e15},
+		{"EB", 1e18},
+	}
+
+	// iecByteSizeUnits is the set of IEC (binary) units, in ascending order.
+	iecByteSizeUnits = []byteSizeUnit{
+		{"B", 1},
+		{"KiB", 1 << 10},
+		{"MiB", 1 << 20},
+		{"GiB", 1 << 30},
+		{"TiB", 1 << 40},
+		{"PiB", 1 << 50},
+		{"EiB", 1 << 60},
+	}
+
+	// byteSizePattern matches the syntax of a byte size.
+	byteSizePattern = regexp.MustCompile(`^([0-9]+(?:\.[0-9]+)?)\s*([a-zA-Z]*)$`)
+)
+
+type byteSizeMarshaler struct {
+	// SI indicates that SI units are used for the canonical representation,
+	// instead of IEC units.
+	SI bool
+}
+
+func (m byteSizeMarshaler) Marshal(v uint64) (variable.Literal, error) {
+	return variable.Literal{
+		String: m.format(v),
+	}, nil
+}
+
+func (m byteSizeMarshaler) Unmarshal(v variable.Literal) (uint64, error) {
+	matches := byteSizePattern.FindStringSubmatch(v.String)
+	if matches == nil {
+		return 0, errors.New("unrecognized byte size syntax")
+	}
+
+	number, symbol := matches[1], matches[2]
+	size := uint64(1)
+
+	if symbol != "" {
+		unit, ok := findByteSizeUnit(symbol)
+		if !ok {
+			return 0, fmt.Errorf("unrecognized unit (%s)", symbol)
+		}
+		size = unit.Size
+	}
+
+	n, _ := new(big.Rat).SetString(number)
+	n.Mul(n, new(big.Rat).SetUint64(size))
+
+	if !n.IsInt() {
+		return 0, errors.New("must be a whole number of bytes")
+	}
+
+	if !n.Num().IsUint64() {
+		return 0, errors.New("too large")
+	}
+
+	return n.Num().Uint64(), nil
+}
+
+// format returns the literal representation of v, using the largest unit
+// that represents v exactly.
+func (m byteSizeMarshaler) format(v uint64) string {
+	units := m.units()
+
+	for i := len(units) - 1; i > 0; i-- {
+		u := units[i]
+		if v != 0 && v%u.Size == 0 {
+			return strconv.FormatUint(v/u.Size, 10) + u.Symbol
+		}
+	}
+
+	return strconv.FormatUint(v, 10) + "B"
+}
+
+// units returns the units used for the canonical representation, in
+// ascending order.
+func (m byteSizeMarshaler) units() []byteSizeUnit {
+	if m.SI {
+		return siByteSizeUnits
+	}
+	return iecByteSizeUnits
+}
+
+// findByteSizeUnit returns the unit with the given symbol.
+func findByteSizeUnit(symbol string) (byteSizeUnit, bool) {
+	for _, units := range [][]byteSizeUnit{siByteSizeUnits, iecByteSizeUnits} {
+		for _, u := range units {
+			if strings.EqualFold(u.Symbol, symbol) {
+				return u, true
+			}
+		}
+	}
+
+	return byteSizeUnit{}, false
+}
@@ -0,0 +1,278 @@
+package ferrite_test
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("type ByteSizeBuilder", func() {
+	var builder *ByteSizeBuilder
+
+	BeforeEach(func() {
+		builder = ByteSize("FERRITE_BYTE_SIZE", "<desc>")
+	})
+
+	AfterEach(func() {
+		tearDown()
+	})
+
+	It("panics if the name is empty", func() {
+		Expect(func() {
+			ByteSize("", "<desc>").Optional()
+		}).To(PanicWith("invalid specification: variable name must not be empty"))
+	})
+
+	It("panics if the description is empty", func() {
+		Expect(func() {
+			ByteSize("FERRITE_BYTE_SIZE", "").Optional()
+		}).To(PanicWith("specification for FERRITE_BYTE_SIZE is invalid: variable description must not be empty"))
+	})
+
+	It("panics if the maximum is less than the minimum", func() {
+		Expect(func() {
+			builder.
+				WithMinimum(2 << 20).
+				WithMaximum(1 << 20).
+				Optional()
+		}).To(PanicWith("specification for FERRITE_BYTE_SIZE is invalid: maximum must not be less than the minimum"))
+	})
+
+	It("panics if the default value is outside the limits", func() {
+		Expect(func() {
+			builder.
+				WithMinimum(1 << 20).
+				WithDefault(1 << 10).
+				Optional()
+		}).To(PanicWith("specification for FERRITE_BYTE_SIZE is invalid: default value: too low, expected 1MiB or greater"))
+	})
+
+	When("the variable is required", func() {
+		When("the value is valid", func() {
+			Describe("func Value()", func() {
+				DescribeTable(
+					"it returns the value",
+					func(value string, expect uint64) {
+						os.Setenv("FERRITE_BYTE_SIZE", value)
+
+						v := builder.
+							Required().
+							Value()
+
+						Expect(v).To(Equal(expect))
+					},
+					Entry("plain number of bytes", "4096", uint64(4096)),
+					Entry("bytes", "100B", uint64(100)),
+					Entry("SI unit", "2MB", uint64(2_000_000)),
+					Entry("IEC unit", "2MiB", uint64(2<<20)),
+					Entry("decimal number", "1.5GB", uint64(1_500_000_000)),
+					Entry("decimal number with IEC unit", "0.5KiB", uint64(512)),
+					Entry("lowercase unit", "512mib", uint64(512<<20)),
+					Entry("space before unit", "1 GiB", uint64(1<<30)),
+					Entry("maximum value", "18446744073709551615", uint64(18446744073709551615)),
+				)
+			})
+		})
+
+		When("the value is invalid", func() {
+			Describe("func Value()", func() {
+				DescribeTable(
+					"it panics",
+					func(configure func(*ByteSizeBuilder), value, expect string) {
+						os.Setenv("FERRITE_BYTE_SIZE", value)
+
+						configure(builder)
+
+						Expect(func() {
+							builder.
+								Required().
+								Value()
+						}).To(PanicWith(expect))
+					},
+					Entry(
+						"invalid syntax",
+						func(*ByteSizeBuilder) {},
+						"-1MiB",
+						`value of FERRITE_BYTE_SIZE (-1MiB) is invalid: unrecognized byte size syntax`,
+					),
+					Entry(
+						"unrecognized unit",
+						func(*ByteSizeBuilder) {},
+						"1XB",
+						`value of FERRITE_BYTE_SIZE (1XB) is invalid: unrecognized unit (XB)`,
+					),
+					Entry(
+						"fractional number of bytes",
+						func(*ByteSizeBuilder) {},
+						"1.5B",
+						`value of FERRITE_BYTE_SIZE (1.5B) is invalid: must be a whole number of bytes`,
+					),
+					Entry(
+						"overflow",
+						func(*ByteSizeBuilder) {},
+						"16EiB",
+						`value of FERRITE_BYTE_SIZE (16EiB) is invalid: too large`,
+					),
+					Entry(
+						"less than the minimum",
+						func(b *ByteSizeBuilder) { b.WithMinimum(1 << 20) },
+						"1000KiB",
+						`value of FERRITE_BYTE_SIZE (1000KiB) is invalid: too low, expected 1MiB or greater`,
+					),
+					Entry(
+						"greater than the maximum",
+						func(b *ByteSizeBuilder) {
+							b.
+								WithMinimum(1 << 20).
+								WithMaximum(1 << 30)
+						},
+						"2GiB",
+						`value of FERRITE_BYTE_SIZE (2GiB) is invalid: too high, expected between 1MiB and 1GiB`,
+					),
+				)
+			})
+		})
+
+		When("the value is empty", func() {
+			When("there is a default value", func() {
+				Describe("func Value()", func() {
+					It("returns the default", func() {
+						v := builder.
+							WithDefault(512 << 20).
+							Required().
+							Value()
+
+						Expect(v).To(Equal(uint64(512 << 20)))
+					})
+				})
+			})
+
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("panics", func() {
+						Expect(func() {
+							builder.
+								Required().
+								Value()
+						}).To(PanicWith(
+							"FERRITE_BYTE_SIZE is undefined and does not have a default value",
+						))
+					})
+				})
+			})
+		})
+	})
+
+	When("the variable is optional", func() {
+		When("the value is empty", func() {
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("returns with ok == false", func() {
+						_, ok := builder.
+							Optional().
+							Value()
+
+						Expect(ok).To(BeFalse())
+					})
+				})
+			})
+		})
+	})
+})
+
+func ExampleByteSize_required() {
+	defer example()()
+
+	v := ferrite.
+		ByteSize("FERRITE_BYTE_SIZE", "example byte-size variable").
+		Required()
+
+	os.Setenv("FERRITE_BYTE_SIZE", "1.5KiB")
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is 1536
+}
+
+func ExampleByteSize_default() {
+	defer example()()
+
+	v := ferrite.
+		ByteSize("FERRITE_BYTE_SIZE", "example byte-size variable").
+		WithDefault(512 << 20).
+		Required()
+
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is 536870912
+}
+
+func ExampleByteSize_optional() {
+	defer example()()
+
+	v := ferrite.
+		ByteSize("FERRITE_BYTE_SIZE", "example byte-size variable").
+		Optional()
+
+	ferrite.Init()
+
+	if x, ok := v.Value(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// value is undefined
+}
+
+func ExampleByteSize_limits() {
+	defer example()()
+
+	v := ferrite.
+		ByteSize("FERRITE_BYTE_SIZE", "example byte-size variable").
+		WithMinimum(1 << 20).
+		WithMaximum(1 << 30).
+		Required()
+
+	os.Setenv("FERRITE_BYTE_SIZE", "64MiB")
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is 67108864
+}
+
+func ExampleByteSize_deprecated() {
+	defer example()()
+
+	os.Setenv("FERRITE_BYTE_SIZE", "1048576")
+	v := ferrite.
+		ByteSize("FERRITE_BYTE_SIZE", "example byte-size variable").
+		Deprecated()
+
+	ferrite.Init()
+
+	if x, ok := v.DeprecatedValue(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// Environment Variables:
+	//
+	//  ❯ FERRITE_BYTE_SIZE  example byte-size variable  [ <uint64> ]  ⚠ deprecated variable set to 1048576, equivalent to 1MiB
+	//
+	// value is 1048576
+}
@@ -66,6 +66,30 @@ func (b *DurationBuilder) WithMaximum(v time.Duration) *DurationBuilder {
 	return b
 }
 
+// WithConstraint adds a constraint to the variable.
+//
+// fn is called with the parsed duration. If it returns false the value is
+// considered invalid.
+func (b *DurationBuilder) WithConstraint(
+	desc string,
+	fn func(time.Duration) bool,
+) *DurationBuilder {
+	b.builder.UserConstraint(desc, fn)
+	return b
+}
+
+// WithConstraintFunc adds a constraint to the variable.
+//
+// It is like [DurationBuilder.WithConstraint], except that fn returns an error
+// that explains why the duration is invalid, or nil if it is valid.
+func (b *DurationBuilder) WithConstraintFunc(
+	desc string,
+	fn func(time.Duration) error,
+) *DurationBuilder {
+	b.builder.UserConstraintFunc(desc, fn)
+	return b
+}
+
 // Required completes the build process and registers a required variable with
 // Ferrite's validation system.
 func (b *DurationBuilder) Required(options ...RequiredOption) Required[time.Duration] {
@@ -84,6 +108,10 @@ func (b *DurationBuilder) Deprecated(options ...DeprecatedOption) Deprecated[tim
 	return deprecated(b.schema, &b.builder, options...)
 }
 
+func (b *DurationBuilder) element() (variable.TypedSchema[time.Duration], *variable.TypedSpecBuilder[time.Duration]) {
+	return b.schema, &b.builder
+}
+
 type durationMarshaler struct{}
 
 func (durationMarshaler) Marshal(v time.Duration) (variable.Literal, error) {
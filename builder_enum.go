@@ -85,6 +85,30 @@ func (b *EnumBuilder[T]) WithDefault(v T) *EnumBuilder[T] {
 	return b
 }
 
+// WithConstraint adds a constraint to the variable.
+//
+// fn is called with the member that the value maps to. If it returns false the
+// value is considered invalid.
+func (b *EnumBuilder[T]) WithConstraint(
+	desc string,
+	fn func(T) bool,
+) *EnumBuilder[T] {
+	b.builder.UserConstraint(desc, fn)
+	return b
+}
+
+// WithConstraintFunc adds a constraint to the variable.
+//
+// It is like [EnumBuilder.WithConstraint], except that fn returns an error that
+// explains why the member is invalid, or nil if it is valid.
+func (b *EnumBuilder[T]) WithConstraintFunc(
+	desc string,
+	fn func(T) error,
+) *EnumBuilder[T] {
+	b.builder.UserConstraintFunc(desc, fn)
+	return b
+}
+
 // Required completes the build process and registers a required variable with
 // Ferrite's validation system.
 func (b *EnumBuilder[T]) Required(options ...RequiredOption) Required[T] {
@@ -102,3 +126,7 @@ func (b *EnumBuilder[T]) Optional(options ...OptionalOption) Optional[T] {
 func (b *EnumBuilder[T]) Deprecated(options ...DeprecatedOption) Deprecated[T] {
 	return deprecated(b.schema, &b.builder, options...)
 }
+
+func (b *EnumBuilder[T]) element() (variable.TypedSchema[T], *variable.TypedSpecBuilder[T]) {
+	return b.schema, &b.builder
+}
@@ -36,6 +36,30 @@ func (b *FileBuilder) WithDefault(v string) *FileBuilder {
 	return b
 }
 
+// WithConstraint adds a constraint to the variable.
+//
+// fn is called with the file name; the file itself is not opened. If it returns
+// false the value is considered invalid.
+func (b *FileBuilder) WithConstraint(
+	desc string,
+	fn func(FileName) bool,
+) *FileBuilder {
+	b.builder.UserConstraint(desc, fn)
+	return b
+}
+
+// WithConstraintFunc adds a constraint to the variable.
+//
+// It is like [FileBuilder.WithConstraint], except that fn returns an error that
+// explains why the file name is invalid, or nil if it is valid.
+func (b *FileBuilder) WithConstraintFunc(
+	desc string,
+	fn func(FileName) error,
+) *FileBuilder {
+	b.builder.UserConstraintFunc(desc, fn)
+	return b
+}
+
 // Required completes the build process and registers a required variable with
 // Ferrite's validation system.
 func (b *FileBuilder) Required(options ...RequiredOption) Required[FileName] {
@@ -54,6 +78,10 @@ func (b *FileBuilder) Deprecated(options ...DeprecatedOption) Deprecated[FileNam
 	return deprecated(b.schema, &b.builder, options...)
 }
 
+func (b *FileBuilder) element() (variable.TypedSchema[FileName], *variable.TypedSpecBuilder[FileName]) {
+	return b.schema, &b.builder
+}
+
 // FileName is the name of a file.
 type FileName string
 
@@ -89,6 +89,30 @@ func (b *FloatBuilder[T]) WithMaximum(v T) *FloatBuilder[T] {
 	return b
 }
 
+// WithConstraint adds a constraint to the variable.
+//
+// fn is called with the parsed number. If it returns false the value is
+// considered invalid.
+func (b *FloatBuilder[T]) WithConstraint(
+	desc string,
+	fn func(T) bool,
+) *FloatBuilder[T] {
+	b.builder.UserConstraint(desc, fn)
+	return b
+}
+
+// WithConstraintFunc adds a constraint to the variable.
+//
+// It is like [FloatBuilder.WithConstraint], except that fn returns an error
+// that explains why the number is invalid, or nil if it is valid.
+func (b *FloatBuilder[T]) WithConstraintFunc(
+	desc string,
+	fn func(T) error,
+) *FloatBuilder[T] {
+	b.builder.UserConstraintFunc(desc, fn)
+	return b
+}
+
 // Required completes the build process and registers a required variable with
 // Ferrite's validation system.
 func (b *FloatBuilder[T]) Required(options ...RequiredOption) Required[T] {
@@ -107,6 +131,10 @@ func (b *FloatBuilder[T]) Deprecated(options ...DeprecatedOption) Deprecated[T]
 	return deprecated(b.schema, &b.builder, options...)
 }
 
+func (b *FloatBuilder[T]) element() (variable.TypedSchema[T], *variable.TypedSpecBuilder[T]) {
+	return b.schema, &b.builder
+}
+
 type floatMarshaler[T constraints.Float] struct{}
 
 func (floatMarshaler[T]) Marshal(v T) (variable.Literal, error) {
@@ -0,0 +1,254 @@
+package ferrite
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/dogmatiq/ferrite/internal/variable"
+)
+
+// IPAddr configures an environment variable as an IPv4 or IPv6 address.
+//
+// name is the name of the environment variable to read. desc is a
+// human-readable description of the environment variable.
+func IPAddr(name, desc string) *IPAddrBuilder {
+	b := &IPAddrBuilder{
+		schema: variable.TypedOther[netip.Addr]{
+			Marshaler: ipAddrMarshaler{},
+		},
+		examples: []variable.TypedExample[netip.Addr]{
+			{
+				Native:      netip.MustParseAddr("192.0.2.1"),
+				Description: "an IPv4 address",
+			},
+			{
+				Native:      netip.MustParseAddr("2001:db8::1"),
+				Description: "an IPv6 address",
+			},
+		},
+	}
+
+	b.builder.Name(name)
+	b.builder.Description(desc)
+	b.builder.Documentation().
+		Summary("IP address syntax").
+		Paragraph(
+			"IPv4 addresses are specified using dotted-decimal notation, such as `192.0.2.1`.",
+			"IPv6 addresses are specified using hexadecimal notation as described in RFC 4291, such as `2001:db8::1`.",
+		).
+		Format().
+		Done()
+
+	return b
+}
+
+// IPAddrBuilder builds a specification for an IP address variable.
+type IPAddrBuilder struct {
+	schema   variable.TypedOther[netip.Addr]
+	builder  variable.TypedSpecBuilder[netip.Addr]
+	examples []variable.TypedExample[netip.Addr]
+	built    bool
+}
+
+var _ isBuilderOf[netip.Addr, *IPAddrBuilder]
+
+// WithDefault sets a default value of the variable.
+//
+// It is used when the environment variable is undefined or empty.
+func (b *IPAddrBuilder) WithDefault(v string) *IPAddrBuilder {
+	b.builder.Default(netip.MustParseAddr(v))
+	return b
+}
+
+// WithIPv4Only restricts the variable to IPv4 addresses.
+func (b *IPAddrBuilder) WithIPv4Only() *IPAddrBuilder {
+	b.builder.BuiltInConstraint(
+		"**MUST** be an IPv4 address",
+		func(v netip.Addr) variable.ConstraintError {
+			if !v.Is4() {
+				return errors.New("expected an IPv4 address")
+			}
+			return nil
+		},
+	)
+	return b
+}
+
+// WithIPv6Only restricts the variable to IPv6 addresses.
+func (b *IPAddrBuilder) WithIPv6Only() *IPAddrBuilder {
+	b.builder.BuiltInConstraint(
+		"**MUST** be an IPv6 address",
+		func(v netip.Addr) variable.ConstraintError {
+			if !v.Is6() {
+				return errors.New("expected an IPv6 address")
+			}
+			return nil
+		},
+	)
+	return b
+}
+
+// WithoutLoopback disallows loopback addresses, such as 127.0.0.1 and ::1.
+func (b *IPAddrBuilder) WithoutLoopback() *IPAddrBuilder {
+	b.builder.BuiltInConstraint(
+		"**MUST NOT** be a loopback address",
+		func(v netip.Addr) variable.ConstraintError {
+			if v.IsLoopback() {
+				return errors.New("must not be a loopback address")
+			}
+			return nil
+		},
+	)
+	return b
+}
+
+// WithoutUnspecified disallows the "unspecified" addresses, 0.0.0.0 and ::.
+func (b *IPAddrBuilder) WithoutUnspecified() *IPAddrBuilder {
+	b.builder.BuiltInConstraint(
+		"**MUST NOT** be an unspecified address",
+		func(v netip.Addr) variable.ConstraintError {
+			if v.IsUnspecified() {
+				return errors.New("must not be an unspecified address")
+			}
+			return nil
+		},
+	)
+	return b
+}
+
+// WithinPrefix requires the address to be contained within the given prefix,
+// expressed in CIDR notation, such as "10.0.0.0/8".
+//
+// It may be called multiple times, in which case the address must be contained
+// within every one of the prefixes.
+func (b *IPAddrBuilder) WithinPrefix(prefix string) *IPAddrBuilder {
+	p := netip.MustParsePrefix(prefix).Masked()
+
+	b.builder.BuiltInConstraint(
+		fmt.Sprintf("**MUST** be within the `%s` prefix", p),
+		func(v netip.Addr) variable.ConstraintError {
+			if !p.Contains(v) {
+				return fmt.Errorf("expected an address within %s", p)
+			}
+			return nil
+		},
+	)
+
+	// Add an example of an address from within the prefix, skipping the
+	// network address itself where possible.
+	eg := p.Addr()
+	if next := eg.Next(); p.Contains(next) {
+		eg = next
+	}
+
+	b.examples = append(
+		b.examples,
+		variable.TypedExample[netip.Addr]{
+			Native:      eg,
+			Description: fmt.Sprintf("an address within %s", p),
+		},
+	)
+
+	return b
+}
+
+// WithConstraint adds a constraint to the variable.
+//
+// fn is called with the parsed IP address. If it returns false the value is
+// considered invalid.
+func (b *IPAddrBuilder) WithConstraint(
+	desc string,
+	fn func(netip.Addr) bool,
+) *IPAddrBuilder {
+	b.builder.UserConstraint(desc, fn)
+	return b
+}
+
+// WithConstraintFunc adds a constraint to the variable.
+//
+// It is like [IPAddrBuilder.WithConstraint], except that fn returns an error
+// that explains why the address is invalid, or nil if it is valid.
+func (b *IPAddrBuilder) WithConstraintFunc(
+	desc string,
+	fn func(netip.Addr) error,
+) *IPAddrBuilder {
+	b.builder.UserConstraintFunc(desc, fn)
+	return b
+}
+
+// Required completes the build process and registers a required variable with
+// Ferrite's validation system.
+func (b *IPAddrBuilder) Required(options ...RequiredOption) Required[netip.Addr] {
+	b.build()
+	return required(b.schema, &b.builder, options...)
+}
+
+// Optional completes the build process and registers an optional variable with
+// Ferrite's validation system.
+func (b *IPAddrBuilder) Optional(options ...OptionalOption) Optional[netip.Addr] {
+	b.build()
+	return optional(b.schema, &b.builder, options...)
+}
+
+// Deprecated completes the build process and registers a deprecated variable
+// with Ferrite's validation system.
+func (b *IPAddrBuilder) Deprecated(options ...DeprecatedOption) Deprecated[netip.Addr] {
+	b.build()
+	return deprecated(b.schema, &b.builder, options...)
+}
+
+func (b *IPAddrBuilder) element() (variable.TypedSchema[netip.Addr], *variable.TypedSpecBuilder[netip.Addr]) {
+	b.build()
+	return b.schema, &b.builder
+}
+
+// build adds the examples that meet the variable's constraints, which may be
+// configured in any order.
+func (b *IPAddrBuilder) build() {
+	if !b.built {
+		b.built = true
+		addExamples(&b.builder, b.examples...)
+	}
+}
+
+type ipAddrMarshaler struct{}
+
+func (ipAddrMarshaler) Marshal(v netip.Addr) (variable.Literal, error) {
+	if v == (netip.Addr{}) {
+		// The zero value is represented as an empty literal, such as when
+		// describing the conditions of other variables that depend on this one.
+		return variable.Literal{}, nil
+	}
+
+	if !v.IsValid() {
+		return variable.Literal{}, errors.New("invalid IP address")
+	}
+
+	return variable.Literal{
+		String: v.String(),
+	}, nil
+}
+
+func (ipAddrMarshaler) Unmarshal(v variable.Literal) (netip.Addr, error) {
+	a, err := netip.ParseAddr(v.String)
+	if err != nil {
+		return netip.Addr{}, trimParseError(err, "ParseAddr", v)
+	}
+
+	return a, nil
+}
+
+// trimParseError removes the redundant function name and input value from the
+// errors produced by the netip package.
+func trimParseError(err error, fn string, v variable.Literal) error {
+	m := err.Error()
+	prefix := fmt.Sprintf("%s(%q): ", fn, v.String)
+
+	if !strings.HasPrefix(m, prefix) {
+		return err
+	}
+
+	return errors.New(strings.TrimPrefix(m, prefix))
+}
@@ -0,0 +1,252 @@
+package ferrite_test
+
+import (
+	"fmt"
+	"net/netip"
+	"os"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("type IPAddrBuilder", func() {
+	var builder *IPAddrBuilder
+
+	BeforeEach(func() {
+		builder = IPAddr("FERRITE_IP_ADDR", "<desc>")
+	})
+
+	AfterEach(func() {
+		tearDown()
+	})
+
+	It("panics if the name is empty", func() {
+		Expect(func() {
+			IPAddr("", "<desc>").Optional()
+		}).To(PanicWith("invalid specification: variable name must not be empty"))
+	})
+
+	It("panics if the description is empty", func() {
+		Expect(func() {
+			IPAddr("FERRITE_IP_ADDR", "").Optional()
+		}).To(PanicWith("specification for FERRITE_IP_ADDR is invalid: variable description must not be empty"))
+	})
+
+	It("panics if the default value does not meet the constraints", func() {
+		Expect(func() {
+			builder.
+				WithIPv6Only().
+				WithDefault("192.0.2.1").
+				Optional()
+		}).To(PanicWith("specification for FERRITE_IP_ADDR is invalid: default value: expected an IPv6 address"))
+	})
+
+	When("the variable is required", func() {
+		When("the value is valid", func() {
+			Describe("func Value()", func() {
+				DescribeTable(
+					"it returns the value",
+					func(value, expect string) {
+						os.Setenv("FERRITE_IP_ADDR", value)
+
+						v := builder.
+							Required().
+							Value()
+
+						Expect(v).To(Equal(netip.MustParseAddr(expect)))
+					},
+					Entry("IPv4", "192.0.2.1", "192.0.2.1"),
+					Entry("IPv6", "2001:db8::1", "2001:db8::1"),
+					Entry("non-canonical IPv6", "2001:0DB8:0:0::1", "2001:db8::1"),
+				)
+			})
+		})
+
+		When("the value is invalid", func() {
+			Describe("func Value()", func() {
+				DescribeTable(
+					"it panics",
+					func(value, expect string) {
+						os.Setenv("FERRITE_IP_ADDR", value)
+
+						Expect(func() {
+							builder.
+								WithIPv4Only().
+								WithoutLoopback().
+								WithoutUnspecified().
+								WithinPrefix("10.0.0.0/8").
+								Required().
+								Value()
+						}).To(PanicWith(expect))
+					},
+					Entry(
+						"invalid syntax",
+						"10.0.0",
+						`value of FERRITE_IP_ADDR (10.0.0) is invalid: IPv4 address too short`,
+					),
+					Entry(
+						"IPv6 address",
+						"2001:db8::1",
+						`value of FERRITE_IP_ADDR (2001:db8::1) is invalid: expected an IPv4 address`,
+					),
+					Entry(
+						"loopback address",
+						"127.0.0.1",
+						`value of FERRITE_IP_ADDR (127.0.0.1) is invalid: must not be a loopback address`,
+					),
+					Entry(
+						"unspecified address",
+						"0.0.0.0",
+						`value of FERRITE_IP_ADDR (0.0.0.0) is invalid: must not be an unspecified address`,
+					),
+					Entry(
+						"address outside of prefix",
+						"192.168.0.1",
+						`value of FERRITE_IP_ADDR (192.168.0.1) is invalid: expected an address within 10.0.0.0/8`,
+					),
+				)
+			})
+		})
+
+		When("the value is empty", func() {
+			When("there is a default value", func() {
+				Describe("func Value()", func() {
+					It("returns the default", func() {
+						v := builder.
+							WithDefault("192.0.2.1").
+							Required().
+							Value()
+
+						Expect(v).To(Equal(netip.MustParseAddr("192.0.2.1")))
+					})
+				})
+			})
+
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("panics", func() {
+						Expect(func() {
+							builder.
+								Required().
+								Value()
+						}).To(PanicWith(
+							"FERRITE_IP_ADDR is undefined and does not have a default value",
+						))
+					})
+				})
+			})
+		})
+	})
+
+	When("the variable is optional", func() {
+		When("the value is empty", func() {
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("returns with ok == false", func() {
+						_, ok := builder.
+							Optional().
+							Value()
+
+						Expect(ok).To(BeFalse())
+					})
+				})
+			})
+		})
+	})
+})
+
+func ExampleIPAddr_required() {
+	defer example()()
+
+	v := ferrite.
+		IPAddr("FERRITE_IP_ADDR", "example IP address variable").
+		Required()
+
+	os.Setenv("FERRITE_IP_ADDR", "192.0.2.1")
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is 192.0.2.1
+}
+
+func ExampleIPAddr_default() {
+	defer example()()
+
+	v := ferrite.
+		IPAddr("FERRITE_IP_ADDR", "example IP address variable").
+		WithDefault("::1").
+		Required()
+
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is ::1
+}
+
+func ExampleIPAddr_optional() {
+	defer example()()
+
+	v := ferrite.
+		IPAddr("FERRITE_IP_ADDR", "example IP address variable").
+		Optional()
+
+	ferrite.Init()
+
+	if x, ok := v.Value(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// value is undefined
+}
+
+func ExampleIPAddr_restricted() {
+	defer example()()
+
+	v := ferrite.
+		IPAddr("FERRITE_IP_ADDR", "example IP address variable").
+		WithIPv4Only().
+		WithoutLoopback().
+		WithinPrefix("10.0.0.0/8").
+		Required()
+
+	os.Setenv("FERRITE_IP_ADDR", "10.1.2.3")
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is 10.1.2.3
+}
+
+func ExampleIPAddr_deprecated() {
+	defer example()()
+
+	os.Setenv("FERRITE_IP_ADDR", "2001:0db8::0001")
+	v := ferrite.
+		IPAddr("FERRITE_IP_ADDR", "example IP address variable").
+		Deprecated()
+
+	ferrite.Init()
+
+	if x, ok := v.DeprecatedValue(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// Environment Variables:
+	//
+	//  ❯ FERRITE_IP_ADDR  example IP address variable  [ <string> ]  ⚠ deprecated variable set to 2001:0db8::0001, equivalent to 2001:db8::1
+	//
+	// value is 2001:db8::1
+}
@@ -54,6 +54,7 @@ func KubernetesService(svc string) *KubernetesServiceBuilder {
 			b.service,
 		),
 	)
+	b.hostBuilder.MarkDefinedByKubernetes()
 	b.hostBuilder.BuiltInConstraint(
 		"**MUST** be a valid hostname",
 		func(h string) variable.ConstraintError {
@@ -78,6 +79,7 @@ func KubernetesService(svc string) *KubernetesServiceBuilder {
 			b.service,
 		),
 	)
+	b.portBuilder.MarkDefinedByKubernetes()
 	b.portBuilder.BuiltInConstraint(
 		"**MUST** be a valid network port",
 		func(p string) variable.ConstraintError {
@@ -185,7 +187,9 @@ func (b *KubernetesServiceBuilder) Required(options ...RequiredOption) Required[
 
 	return requiredFunc[KubernetesAddress]{
 		[]variable.Any{host, port},
-		func() (KubernetesAddress, error) {
+		func(s *variable.Scope) (KubernetesAddress, error) {
+			host, port := host.In(s), port.In(s)
+
 			if err := host.Error(); err != nil {
 				return KubernetesAddress{}, err
 			}
@@ -250,6 +254,8 @@ func (b *KubernetesServiceBuilder) Deprecated(options ...DeprecatedOption) Depre
 		b.portBuilder.Done(b.portSchema),
 	)
 
+	cfg.registered(host, port)
+
 	return deprecatedFunc[KubernetesAddress]{
 		[]variable.Any{host, port},
 		b.optionalResolver(host, port),
@@ -258,8 +264,10 @@ func (b *KubernetesServiceBuilder) Deprecated(options ...DeprecatedOption) Depre
 
 func (b *KubernetesServiceBuilder) optionalResolver(
 	host, port *variable.OfType[string],
-) func() (KubernetesAddress, bool, error) {
-	return func() (KubernetesAddress, bool, error) {
+) func(*variable.Scope) (KubernetesAddress, bool, error) {
+	return func(s *variable.Scope) (KubernetesAddress, bool, error) {
+		host, port := host.In(s), port.In(s)
+
 		if err := host.Error(); err != nil {
 			return KubernetesAddress{}, false, err
 		}
@@ -267,25 +275,18 @@ func (b *KubernetesServiceBuilder) optionalResolver(
 			return KubernetesAddress{}, false, err
 		}
 
-		availability := host.Availability()
-
-		if port.Availability() != availability {
-			def, undef := host, port
-			if availability != variable.AvailabilityOK {
-				def, undef = undef, def
-			}
-
-			return KubernetesAddress{}, false, fmt.Errorf(
-				"%s is defined but %s is not, define both or neither",
-				def.Spec().Name(),
-				undef.Spec().Name(),
-			)
+		if err := variable.CheckGroup(
+			variable.AllOrNone,
+			[]variable.Any{host},
+			[]variable.Any{port},
+		); err != nil {
+			return KubernetesAddress{}, false, err
 		}
 
 		return KubernetesAddress{
 			host.NativeValue(),
 			port.NativeValue(),
-		}, availability == variable.AvailabilityOK, nil
+		}, host.Availability() == variable.AvailabilityOK, nil
 	}
 }
 
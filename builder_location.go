@@ -0,0 +1,163 @@
+package ferrite
+
+import (
+	"errors"
+	"time"
+
+	// Embed the IANA Time Zone Database so that time zones can be loaded even
+	// when the system does not provide one, such as in minimal container
+	// images.
+	_ "time/tzdata"
+
+	"github.com/dogmatiq/ferrite/internal/variable"
+)
+
+// Location configures an environment variable as a time zone.
+//
+// The value must be a name from the IANA Time Zone Database, such as
+// "America/New_York", or "UTC".
+//
+// name is the name of the environment variable to read. desc is a
+// human-readable description of the environment variable.
+func Location(name, desc string) *LocationBuilder {
+	b := &LocationBuilder{
+		schema: variable.TypedOther[*time.Location]{
+			Marshaler: locationMarshaler{},
+		},
+		examples: []variable.TypedExample[*time.Location]{
+			{
+				Native:      time.UTC,
+				Description: "Coordinated Universal Time",
+			},
+			{
+				Native:      mustLoadLocation("America/New_York"),
+				Description: "a time zone that observes daylight saving time",
+			},
+			{
+				Native:      mustLoadLocation("Australia/Brisbane"),
+				Description: "a time zone that does not observe daylight saving time",
+			},
+		},
+	}
+
+	b.builder.Name(name)
+	b.builder.Description(desc)
+	b.builder.Documentation().
+		Summary("Time zone syntax").
+		Paragraph(
+			"Time zones are specified using names from the IANA Time Zone Database, such as `Europe/London`.",
+			"The value is case-sensitive.",
+		).
+		Format().
+		Done()
+
+	return b
+}
+
+// LocationBuilder builds a specification for a time zone variable.
+type LocationBuilder struct {
+	schema   variable.TypedOther[*time.Location]
+	builder  variable.TypedSpecBuilder[*time.Location]
+	examples []variable.TypedExample[*time.Location]
+	built    bool
+}
+
+var _ isBuilderOf[*time.Location, *LocationBuilder]
+
+// WithDefault sets a default value of the variable.
+//
+// It is used when the environment variable is undefined or empty.
+func (b *LocationBuilder) WithDefault(v string) *LocationBuilder {
+	b.builder.Default(mustLoadLocation(v))
+	return b
+}
+
+// WithConstraint adds a constraint to the variable.
+//
+// fn is called with the time zone that the value names. If it returns false the
+// value is considered invalid.
+func (b *LocationBuilder) WithConstraint(
+	desc string,
+	fn func(*time.Location) bool,
+) *LocationBuilder {
+	b.builder.UserConstraint(desc, fn)
+	return b
+}
+
+// WithConstraintFunc adds a constraint to the variable.
+//
+// It is like [LocationBuilder.WithConstraint], except that fn returns an error
+// that explains why the time zone is invalid, or nil if it is valid.
+func (b *LocationBuilder) WithConstraintFunc(
+	desc string,
+	fn func(*time.Location) error,
+) *LocationBuilder {
+	b.builder.UserConstraintFunc(desc, fn)
+	return b
+}
+
+// Required completes the build process and registers a required variable with
+// Ferrite's validation system.
+func (b *LocationBuilder) Required(options ...RequiredOption) Required[*time.Location] {
+	b.build()
+	return required(b.schema, &b.builder, options...)
+}
+
+// Optional completes the build process and registers an optional variable with
+// Ferrite's validation system.
+func (b *LocationBuilder) Optional(options ...OptionalOption) Optional[*time.Location] {
+	b.build()
+	return optional(b.schema, &b.builder, options...)
+}
+
+// Deprecated completes the build process and registers a deprecated variable
+// with Ferrite's validation system.
+func (b *LocationBuilder) Deprecated(options ...DeprecatedOption) Deprecated[*time.Location] {
+	b.build()
+	return deprecated(b.schema, &b.builder, options...)
+}
+
+func (b *LocationBuilder) element() (variable.TypedSchema[*time.Location], *variable.TypedSpecBuilder[*time.Location]) {
+	b.build()
+	return b.schema, &b.builder
+}
+
+// build adds the examples that meet the variable's constraints, which may be
+// configured in any order.
+func (b *LocationBuilder) build() {
+	if !b.built {
+		b.built = true
+		addExamples(&b.builder, b.examples...)
+	}
+}
+
+type locationMarshaler struct{}
+
+func (locationMarshaler) Marshal(v *time.Location) (variable.Literal, error) {
+	return variable.Literal{
+		String: v.String(),
+	}, nil
+}
+
+func (locationMarshaler) Unmarshal(v variable.Literal) (*time.Location, error) {
+	// The "Local" location depends on the configuration of the host system,
+	// rather than the value of the environment variable.
+	if v.String == "Local" {
+		return nil, errors.New("expected an IANA time zone name")
+	}
+
+	loc, err := time.LoadLocation(v.String)
+	if err != nil {
+		return nil, errors.New("unknown time zone")
+	}
+
+	return loc, nil
+}
+
+func mustLoadLocation(name string) *time.Location {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		panic(err)
+	}
+	return loc
+}
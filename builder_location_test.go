@@ -0,0 +1,205 @@
+package ferrite_test
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("type LocationBuilder", func() {
+	var builder *LocationBuilder
+
+	BeforeEach(func() {
+		builder = Location("FERRITE_LOCATION", "<desc>")
+	})
+
+	AfterEach(func() {
+		tearDown()
+	})
+
+	It("panics if the name is empty", func() {
+		Expect(func() {
+			Location("", "<desc>").Optional()
+		}).To(PanicWith("invalid specification: variable name must not be empty"))
+	})
+
+	It("panics if the description is empty", func() {
+		Expect(func() {
+			Location("FERRITE_LOCATION", "").Optional()
+		}).To(PanicWith("specification for FERRITE_LOCATION is invalid: variable description must not be empty"))
+	})
+
+	When("the variable is required", func() {
+		When("the value is valid", func() {
+			Describe("func Value()", func() {
+				DescribeTable(
+					"it returns the value",
+					func(value string) {
+						os.Setenv("FERRITE_LOCATION", value)
+
+						v := builder.
+							Required().
+							Value()
+
+						Expect(v.String()).To(Equal(value))
+					},
+					Entry("UTC", "UTC"),
+					Entry("IANA time zone", "America/New_York"),
+				)
+			})
+		})
+
+		When("the value is invalid", func() {
+			Describe("func Value()", func() {
+				DescribeTable(
+					"it panics",
+					func(value, expect string) {
+						os.Setenv("FERRITE_LOCATION", value)
+
+						Expect(func() {
+							builder.
+								Required().
+								Value()
+						}).To(PanicWith(expect))
+					},
+					Entry(
+						"unknown time zone",
+						"Mars/Olympus_Mons",
+						`value of FERRITE_LOCATION (Mars/Olympus_Mons) is invalid: unknown time zone`,
+					),
+					Entry(
+						"local time zone",
+						"Local",
+						`value of FERRITE_LOCATION (Local) is invalid: expected an IANA time zone name`,
+					),
+				)
+			})
+		})
+
+		When("the value is empty", func() {
+			When("there is a default value", func() {
+				Describe("func Value()", func() {
+					It("returns the default", func() {
+						v := builder.
+							WithDefault("Europe/London").
+							Required().
+							Value()
+
+						Expect(v.String()).To(Equal("Europe/London"))
+					})
+				})
+			})
+
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("panics", func() {
+						Expect(func() {
+							builder.
+								Required().
+								Value()
+						}).To(PanicWith(
+							"FERRITE_LOCATION is undefined and does not have a default value",
+						))
+					})
+				})
+			})
+		})
+	})
+
+	When("the variable is optional", func() {
+		When("the value is empty", func() {
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("returns with ok == false", func() {
+						_, ok := builder.
+							Optional().
+							Value()
+
+						Expect(ok).To(BeFalse())
+					})
+				})
+			})
+		})
+	})
+})
+
+func ExampleLocation_required() {
+	defer example()()
+
+	v := ferrite.
+		Location("FERRITE_LOCATION", "example location variable").
+		Required()
+
+	os.Setenv("FERRITE_LOCATION", "Australia/Brisbane")
+	ferrite.Init()
+
+	t := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	fmt.Println("local time is", t.In(v.Value()))
+
+	// Output:
+	// local time is 2024-01-01 10:00:00 +1000 AEST
+}
+
+func ExampleLocation_default() {
+	defer example()()
+
+	v := ferrite.
+		Location("FERRITE_LOCATION", "example location variable").
+		WithDefault("UTC").
+		Required()
+
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is UTC
+}
+
+func ExampleLocation_optional() {
+	defer example()()
+
+	v := ferrite.
+		Location("FERRITE_LOCATION", "example location variable").
+		Optional()
+
+	ferrite.Init()
+
+	if x, ok := v.Value(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// value is undefined
+}
+
+func ExampleLocation_deprecated() {
+	defer example()()
+
+	os.Setenv("FERRITE_LOCATION", "Europe/London")
+	v := ferrite.
+		Location("FERRITE_LOCATION", "example location variable").
+		Deprecated()
+
+	ferrite.Init()
+
+	if x, ok := v.DeprecatedValue(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// Environment Variables:
+	//
+	//  ❯ FERRITE_LOCATION  example location variable  [ <string> ]  ⚠ deprecated variable set to Europe/London
+	//
+	// value is Europe/London
+}
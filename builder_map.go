@@ -0,0 +1,151 @@
+package ferrite
+
+import (
+	"github.com/dogmatiq/ferrite/internal/variable"
+)
+
+// Map configures an environment variable as a comma-separated list of
+// key/value pairs, where both the keys and values are strings.
+//
+// Each key is separated from its value by an equals sign, for example
+// "KEY1=value1,KEY2=value2".
+//
+// name is the name of the environment variable to read. desc is a
+// human-readable description of the environment variable.
+func Map(name, desc string) *MapBuilder[string, string] {
+	return MapOf[string, string](
+		name,
+		desc,
+		String(name, desc),
+		String(name, desc),
+	)
+}
+
+// MapOf configures an environment variable as a comma-separated list of
+// key/value pairs, where the keys are of type K and the values are of type V.
+//
+// name is the name of the environment variable to read. desc is a
+// human-readable description of the environment variable.
+//
+// key and value are builders that describe the keys and values of the map,
+// respectively. They are typically constructed using the same name and
+// description as the map itself. Any default values configured on key or
+// value are ignored.
+func MapOf[K comparable, V any](
+	name, desc string,
+	key Element[K],
+	value Element[V],
+) *MapBuilder[K, V] {
+	keySpec := buildElement(name, desc, key)
+	valueSpec := buildElement(name, desc, value)
+
+	b := &MapBuilder[K, V]{
+		schema: variable.TypedMap[K, V]{
+			KeySpec:   keySpec,
+			ValueSpec: valueSpec,
+			PairSep:   "=",
+			EntrySep:  ",",
+		},
+	}
+
+	b.builder.Name(name)
+	b.builder.Description(desc)
+
+	inheritElement(&b.builder, keySpec)
+	inheritElement(&b.builder, valueSpec)
+
+	return b
+}
+
+// MapBuilder builds a specification for a map variable.
+type MapBuilder[K comparable, V any] struct {
+	schema  variable.TypedMap[K, V]
+	builder variable.TypedSpecBuilder[map[K]V]
+}
+
+var _ isBuilderOf[map[string]string, *MapBuilder[string, string]]
+
+// WithPairSeparator sets the string used to separate each key from its value
+// within the environment variable's value.
+//
+// The default pair separator is an equals sign. Whitespace surrounding each key
+// and value is ignored.
+func (b *MapBuilder[K, V]) WithPairSeparator(sep string) *MapBuilder[K, V] {
+	b.schema.PairSep = sep
+	return b
+}
+
+// WithEntrySeparator sets the string used to separate key/value pairs within
+// the environment variable's value.
+//
+// The default entry separator is a comma. Whitespace surrounding each entry is
+// ignored.
+func (b *MapBuilder[K, V]) WithEntrySeparator(sep string) *MapBuilder[K, V] {
+	b.schema.EntrySep = sep
+	return b
+}
+
+// WithRequiredKeys adds keys that must be present in the map.
+func (b *MapBuilder[K, V]) WithRequiredKeys(keys ...K) *MapBuilder[K, V] {
+	b.schema.ReqKeys = append(b.schema.ReqKeys, keys...)
+	return b
+}
+
+// WithDefault sets a default value of the variable.
+//
+// It is used when the environment variable is undefined or empty.
+func (b *MapBuilder[K, V]) WithDefault(v map[K]V) *MapBuilder[K, V] {
+	b.builder.Default(v)
+	return b
+}
+
+// WithConstraint adds a constraint to the variable.
+//
+// fn is called with the map, once all of its keys and values are parsed. If it
+// returns false the value is considered invalid.
+func (b *MapBuilder[K, V]) WithConstraint(
+	desc string,
+	fn func(map[K]V) bool,
+) *MapBuilder[K, V] {
+	b.builder.UserConstraint(desc, fn)
+	return b
+}
+
+// WithConstraintFunc adds a constraint to the variable.
+//
+// It is like [MapBuilder.WithConstraint], except that fn returns an error that
+// explains why the map is invalid, or nil if it is valid.
+func (b *MapBuilder[K, V]) WithConstraintFunc(
+	desc string,
+	fn func(map[K]V) error,
+) *MapBuilder[K, V] {
+	b.builder.UserConstraintFunc(desc, fn)
+	return b
+}
+
+// WithSensitiveContent marks the variable as containing sensitive content.
+//
+// Values of sensitive variables are not printed to the console or included in
+// generated documentation.
+func (b *MapBuilder[K, V]) WithSensitiveContent() *MapBuilder[K, V] {
+	b.builder.MarkSensitive()
+	return b
+}
+
+// Required completes the build process and registers a required variable with
+// Ferrite's validation system.
+func (b *MapBuilder[K, V]) Required(options ...RequiredOption) Required[map[K]V] {
+	return required(b.schema, &b.builder, options...)
+}
+
+// Optional completes the build process and registers an optional variable with
+// Ferrite's validation system.
+func (b *MapBuilder[K, V]) Optional(options ...OptionalOption) Optional[map[K]V] {
+	return optional(b.schema, &b.builder, options...)
+}
+
+// Deprecated completes the build process and registers a deprecated variable
+// with Ferrite's validation system.
+func (b *MapBuilder[K, V]) Deprecated(options ...DeprecatedOption) Deprecated[map[K]V] {
+	return deprecated(b.schema, &b.builder, options...)
+}
@@ -0,0 +1,347 @@
+package ferrite_test
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("type MapBuilder", func() {
+	var builder *MapBuilder[string, uint16]
+
+	BeforeEach(func() {
+		builder = MapOf[string, uint16](
+			"FERRITE_MAP",
+			"<desc>",
+			String("FERRITE_MAP", "<desc>"),
+			Unsigned[uint16]("FERRITE_MAP", "<desc>"),
+		)
+	})
+
+	AfterEach(func() {
+		tearDown()
+	})
+
+	It("panics if the name is empty", func() {
+		Expect(func() {
+			Map("", "<desc>").Optional()
+		}).To(PanicWith("invalid specification: variable name must not be empty"))
+	})
+
+	It("panics if the description is empty", func() {
+		Expect(func() {
+			Map("FERRITE_MAP", "").Optional()
+		}).To(PanicWith("specification for FERRITE_MAP is invalid: variable description must not be empty"))
+	})
+
+	DescribeTable(
+		"it panics if the separators are invalid",
+		func(pair, entry, expect string) {
+			Expect(func() {
+				Map("FERRITE_MAP", "<desc>").
+					WithPairSeparator(pair).
+					WithEntrySeparator(entry).
+					Optional()
+			}).To(PanicWith(expect))
+		},
+		Entry(
+			"empty pair separator",
+			"", ",",
+			"specification for FERRITE_MAP is invalid: pair separator must not be empty or whitespace",
+		),
+		Entry(
+			"empty entry separator",
+			"=", " ",
+			"specification for FERRITE_MAP is invalid: entry separator must not be empty or whitespace",
+		),
+		Entry(
+			"overlapping separators",
+			"=", "==",
+			"specification for FERRITE_MAP is invalid: pair and entry separators must not overlap",
+		),
+	)
+
+	It("panics if a required key is invalid", func() {
+		Expect(func() {
+			MapOf[uint16, string](
+				"FERRITE_MAP",
+				"<desc>",
+				Unsigned[uint16]("FERRITE_MAP", "<desc>").WithMaximum(10),
+				String("FERRITE_MAP", "<desc>"),
+			).
+				WithRequiredKeys(20).
+				Optional()
+		}).To(PanicWith("specification for FERRITE_MAP is invalid: required keys: too high, expected 10 or less"))
+	})
+
+	When("the variable is required", func() {
+		When("the value is valid", func() {
+			Describe("func Value()", func() {
+				DescribeTable(
+					"it returns the value",
+					func(value string, expect map[string]uint16) {
+						os.Setenv("FERRITE_MAP", value)
+
+						v := builder.
+							Required().
+							Value()
+
+						Expect(v).To(Equal(expect))
+					},
+					Entry("single entry", "a=1", map[string]uint16{"a": 1}),
+					Entry("multiple entries", "a=1,b=2", map[string]uint16{"a": 1, "b": 2}),
+					Entry("whitespace around keys and values", " a = 1 , b = 2 ", map[string]uint16{"a": 1, "b": 2}),
+				)
+
+				It("uses the custom separators", func() {
+					os.Setenv("FERRITE_MAP", "a:1;b:2")
+
+					v := builder.
+						WithPairSeparator(":").
+						WithEntrySeparator(";").
+						Required().
+						Value()
+
+					Expect(v).To(Equal(map[string]uint16{"a": 1, "b": 2}))
+				})
+			})
+		})
+
+		When("the value is invalid", func() {
+			Describe("func Value()", func() {
+				DescribeTable(
+					"it panics",
+					func(value, expect string) {
+						os.Setenv("FERRITE_MAP", value)
+
+						Expect(func() {
+							builder.
+								WithRequiredKeys("a").
+								Required().
+								Value()
+						}).To(PanicWith(expect))
+					},
+					Entry(
+						"missing pair separator",
+						"a=1,b",
+						`value of FERRITE_MAP (a=1,b) is invalid: entry 2 (b) is invalid, expected a key and value separated by =`,
+					),
+					Entry(
+						"empty key",
+						"a=1,=2",
+						`value of FERRITE_MAP (a=1,=2) is invalid: entry 2 (=2) is invalid, expected a key and value separated by =`,
+					),
+					Entry(
+						"empty value",
+						"a=1,b=",
+						`value of FERRITE_MAP (a=1,b=) is invalid: entry 2 (b=) is invalid, expected a key and value separated by =`,
+					),
+					Entry(
+						"invalid value",
+						"a=1,b=-2",
+						`value of FERRITE_MAP (a=1,b=-2) is invalid: value of key b (-2) is invalid: unrecognized uint16 syntax`,
+					),
+					Entry(
+						"duplicate key",
+						"a=1,a=2",
+						`value of FERRITE_MAP (a=1,a=2) is invalid: key a is specified more than once, expected unique keys`,
+					),
+					Entry(
+						"missing required key",
+						"b=2",
+						`value of FERRITE_MAP (b=2) is invalid: missing required key a`,
+					),
+				)
+
+				It("panics if a key is invalid", func() {
+					os.Setenv("FERRITE_MAP", "1=a,x=b")
+
+					Expect(func() {
+						MapOf[uint16, string](
+							"FERRITE_MAP",
+							"<desc>",
+							Unsigned[uint16]("FERRITE_MAP", "<desc>"),
+							String("FERRITE_MAP", "<desc>"),
+						).
+							Required().
+							Value()
+					}).To(PanicWith(
+						`value of FERRITE_MAP (1=a,x=b) is invalid: key x is invalid: unrecognized uint16 syntax`,
+					))
+				})
+			})
+		})
+
+		When("the value is empty", func() {
+			When("there is a default value", func() {
+				Describe("func Value()", func() {
+					It("returns the default", func() {
+						v := builder.
+							WithDefault(map[string]uint16{"a": 1}).
+							Required().
+							Value()
+
+						Expect(v).To(Equal(map[string]uint16{"a": 1}))
+					})
+				})
+			})
+
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("panics", func() {
+						Expect(func() {
+							builder.
+								Required().
+								Value()
+						}).To(PanicWith(
+							"FERRITE_MAP is undefined and does not have a default value",
+						))
+					})
+				})
+			})
+		})
+	})
+
+	When("the variable is optional", func() {
+		When("the value is empty", func() {
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("returns with ok == false", func() {
+						_, ok := builder.
+							Optional().
+							Value()
+
+						Expect(ok).To(BeFalse())
+					})
+				})
+			})
+		})
+	})
+})
+
+func ExampleMap_required() {
+	defer example()()
+
+	v := ferrite.
+		Map("FERRITE_MAP", "example map variable").
+		Required()
+
+	os.Setenv("FERRITE_MAP", "env=prod,team=payments")
+	ferrite.Init()
+
+	fmt.Println("env is", v.Value()["env"])
+	fmt.Println("team is", v.Value()["team"])
+
+	// Output:
+	// env is prod
+	// team is payments
+}
+
+func ExampleMap_default() {
+	defer example()()
+
+	v := ferrite.
+		Map("FERRITE_MAP", "example map variable").
+		WithDefault(map[string]string{"env": "dev"}).
+		Required()
+
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is map[env:dev]
+}
+
+func ExampleMap_optional() {
+	defer example()()
+
+	v := ferrite.
+		Map("FERRITE_MAP", "example map variable").
+		Optional()
+
+	ferrite.Init()
+
+	if x, ok := v.Value(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// value is undefined
+}
+
+func ExampleMapOf() {
+	defer example()()
+
+	v := ferrite.MapOf[string, uint16](
+		"FERRITE_MAP",
+		"example map variable",
+		ferrite.String("FERRITE_MAP", "example map variable"),
+		ferrite.Unsigned[uint16]("FERRITE_MAP", "example map variable"),
+	).
+		WithPairSeparator(":").
+		WithEntrySeparator(";").
+		WithRequiredKeys("http").
+		Required()
+
+	os.Setenv("FERRITE_MAP", "http:8080; https:8443")
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is map[http:8080 https:8443]
+}
+
+func ExampleMap_invalidEntry() {
+	defer example()()
+
+	ferrite.MapOf[string, uint16](
+		"FERRITE_MAP",
+		"example map variable",
+		ferrite.String("FERRITE_MAP", "example map variable"),
+		ferrite.Unsigned[uint16]("FERRITE_MAP", "example map variable").
+			WithMinimum(1024),
+	).
+		Required()
+
+	os.Setenv("FERRITE_MAP", "http=8080,https=443")
+	ferrite.Init()
+
+	// Output:
+	// Environment Variables:
+	//
+	//  ❯ FERRITE_MAP  example map variable    <string>=(1024 ...), ...    ✗ set to http=8080,https=443, value of key https (443) is invalid, too low, expected 1024 or greater
+	//
+	// <process exited with error code 1>
+}
+
+func ExampleMap_deprecated() {
+	defer example()()
+
+	os.Setenv("FERRITE_MAP", "b = 2, a = 1")
+	v := ferrite.
+		Map("FERRITE_MAP", "example map variable").
+		Deprecated()
+
+	ferrite.Init()
+
+	if x, ok := v.DeprecatedValue(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// Environment Variables:
+	//
+	//  ❯ FERRITE_MAP  example map variable  [ <string>=<string>, ... ]  ⚠ deprecated variable set to 'b = 2, a = 1', equivalent to a=1,b=2
+	//
+	// value is map[a:1 b:2]
+}
@@ -45,6 +45,30 @@ func (b *NetworkPortBuilder) WithDefault(v string) *NetworkPortBuilder {
 	return b
 }
 
+// WithConstraint adds a constraint to the variable.
+//
+// fn is called with the port, as it appears in the environment. If it returns
+// false the value is considered invalid.
+func (b *NetworkPortBuilder) WithConstraint(
+	desc string,
+	fn func(string) bool,
+) *NetworkPortBuilder {
+	b.builder.UserConstraint(desc, fn)
+	return b
+}
+
+// WithConstraintFunc adds a constraint to the variable.
+//
+// It is like [NetworkPortBuilder.WithConstraint], except that fn returns an
+// error that explains why the port is invalid, or nil if it is valid.
+func (b *NetworkPortBuilder) WithConstraintFunc(
+	desc string,
+	fn func(string) error,
+) *NetworkPortBuilder {
+	b.builder.UserConstraintFunc(desc, fn)
+	return b
+}
+
 // Required completes the build process and registers a required variable with
 // Ferrite's validation system.
 func (b *NetworkPortBuilder) Required(options ...RequiredOption) Required[string] {
@@ -63,6 +87,10 @@ func (b *NetworkPortBuilder) Deprecated(options ...DeprecatedOption) Deprecated[
 	return deprecated(b.schema, &b.builder, options...)
 }
 
+func (b *NetworkPortBuilder) element() (variable.TypedSchema[string], *variable.TypedSpecBuilder[string]) {
+	return b.schema, &b.builder
+}
+
 // validateHost returns an error of port is not a valid numeric port or IANA
 // service name.
 func validatePort(port string) error {
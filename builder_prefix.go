@@ -0,0 +1,206 @@
+package ferrite
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+
+	"github.com/dogmatiq/ferrite/internal/variable"
+)
+
+// Prefix configures an environment variable as an IP network prefix expressed
+// in CIDR notation, such as "10.0.0.0/8" or "2001:db8::/32".
+//
+// name is the name of the environment variable to read. desc is a
+// human-readable description of the environment variable.
+func Prefix(name, desc string) *PrefixBuilder {
+	b := &PrefixBuilder{
+		schema: variable.TypedOther[netip.Prefix]{
+			Marshaler: prefixMarshaler{},
+		},
+		examples: []variable.TypedExample[netip.Prefix]{
+			{
+				Native:      netip.MustParsePrefix("192.0.2.0/24"),
+				Description: "an IPv4 prefix",
+			},
+			{
+				Native:      netip.MustParsePrefix("2001:db8::/32"),
+				Description: "an IPv6 prefix",
+			},
+		},
+	}
+
+	b.builder.Name(name)
+	b.builder.Description(desc)
+	b.builder.Documentation().
+		Summary("CIDR notation").
+		Paragraph(
+			"A prefix is specified as an IP address followed by a slash and the number of leading bits in the network mask,",
+			"such as `192.0.2.0/24` or `2001:db8::/32`.",
+		).
+		Format().
+		Done()
+
+	return b
+}
+
+// PrefixBuilder builds a specification for an IP network prefix variable.
+type PrefixBuilder struct {
+	schema   variable.TypedOther[netip.Prefix]
+	builder  variable.TypedSpecBuilder[netip.Prefix]
+	examples []variable.TypedExample[netip.Prefix]
+	built    bool
+}
+
+var _ isBuilderOf[netip.Prefix, *PrefixBuilder]
+
+// WithDefault sets a default value of the variable.
+//
+// It is used when the environment variable is undefined or empty.
+func (b *PrefixBuilder) WithDefault(v string) *PrefixBuilder {
+	b.builder.Default(netip.MustParsePrefix(v))
+	return b
+}
+
+// WithIPv4Only restricts the variable to IPv4 prefixes.
+func (b *PrefixBuilder) WithIPv4Only() *PrefixBuilder {
+	b.builder.BuiltInConstraint(
+		"**MUST** be an IPv4 prefix",
+		func(v netip.Prefix) variable.ConstraintError {
+			if !v.Addr().Is4() {
+				return errors.New("expected an IPv4 prefix")
+			}
+			return nil
+		},
+	)
+	return b
+}
+
+// WithIPv6Only restricts the variable to IPv6 prefixes.
+func (b *PrefixBuilder) WithIPv6Only() *PrefixBuilder {
+	b.builder.BuiltInConstraint(
+		"**MUST** be an IPv6 prefix",
+		func(v netip.Prefix) variable.ConstraintError {
+			if !v.Addr().Is6() {
+				return errors.New("expected an IPv6 prefix")
+			}
+			return nil
+		},
+	)
+	return b
+}
+
+// WithinPrefix requires the prefix to be contained within another prefix,
+// such as "10.0.0.0/8".
+//
+// It may be called multiple times, in which case the prefix must be contained
+// within every one of the given prefixes.
+func (b *PrefixBuilder) WithinPrefix(prefix string) *PrefixBuilder {
+	p := netip.MustParsePrefix(prefix).Masked()
+
+	b.builder.BuiltInConstraint(
+		fmt.Sprintf("**MUST** be within the `%s` prefix", p),
+		func(v netip.Prefix) variable.ConstraintError {
+			if v.Bits() < p.Bits() || !p.Contains(v.Addr()) {
+				return fmt.Errorf("expected a prefix within %s", p)
+			}
+			return nil
+		},
+	)
+
+	b.examples = append(
+		b.examples,
+		variable.TypedExample[netip.Prefix]{
+			Native:      p,
+			Description: "the entire permitted range",
+		},
+	)
+
+	return b
+}
+
+// WithConstraint adds a constraint to the variable.
+//
+// fn is called with the parsed IP prefix. If it returns false the value is
+// considered invalid.
+func (b *PrefixBuilder) WithConstraint(
+	desc string,
+	fn func(netip.Prefix) bool,
+) *PrefixBuilder {
+	b.builder.UserConstraint(desc, fn)
+	return b
+}
+
+// WithConstraintFunc adds a constraint to the variable.
+//
+// It is like [PrefixBuilder.WithConstraint], except that fn returns an error
+// that explains why the prefix is invalid, or nil if it is valid.
+func (b *PrefixBuilder) WithConstraintFunc(
+	desc string,
+	fn func(netip.Prefix) error,
+) *PrefixBuilder {
+	b.builder.UserConstraintFunc(desc, fn)
+	return b
+}
+
+// Required completes the build process and registers a required variable with
+// Ferrite's validation system.
+func (b *PrefixBuilder) Required(options ...RequiredOption) Required[netip.Prefix] {
+	b.build()
+	return required(b.schema, &b.builder, options...)
+}
+
+// Optional completes the build process and registers an optional variable with
+// Ferrite's validation system.
+func (b *PrefixBuilder) Optional(options ...OptionalOption) Optional[netip.Prefix] {
+	b.build()
+	return optional(b.schema, &b.builder, options...)
+}
+
+// Deprecated completes the build process and registers a deprecated variable
+// with Ferrite's validation system.
+func (b *PrefixBuilder) Deprecated(options ...DeprecatedOption) Deprecated[netip.Prefix] {
+	b.build()
+	return deprecated(b.schema, &b.builder, options...)
+}
+
+func (b *PrefixBuilder) element() (variable.TypedSchema[netip.Prefix], *variable.TypedSpecBuilder[netip.Prefix]) {
+	b.build()
+	return b.schema, &b.builder
+}
+
+// build adds the examples that meet the variable's constraints, which may be
+// configured in any order.
+func (b *PrefixBuilder) build() {
+	if !b.built {
+		b.built = true
+		addExamples(&b.builder, b.examples...)
+	}
+}
+
+type prefixMarshaler struct{}
+
+func (prefixMarshaler) Marshal(v netip.Prefix) (variable.Literal, error) {
+	if v == (netip.Prefix{}) {
+		// The zero value is represented as an empty literal, such as when
+		// describing the conditions of other variables that depend on this one.
+		return variable.Literal{}, nil
+	}
+
+	if !v.IsValid() {
+		return variable.Literal{}, errors.New("invalid prefix")
+	}
+
+	return variable.Literal{
+		String: v.String(),
+	}, nil
+}
+
+func (prefixMarshaler) Unmarshal(v variable.Literal) (netip.Prefix, error) {
+	p, err := netip.ParsePrefix(v.String)
+	if err != nil {
+		return netip.Prefix{}, trimParseError(err, "netip.ParsePrefix", v)
+	}
+
+	return p, nil
+}
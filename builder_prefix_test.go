@@ -0,0 +1,217 @@
+package ferrite_test
+
+import (
+	"fmt"
+	"net/netip"
+	"os"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("type PrefixBuilder", func() {
+	var builder *PrefixBuilder
+
+	BeforeEach(func() {
+		builder = Prefix("FERRITE_PREFIX", "<desc>")
+	})
+
+	AfterEach(func() {
+		tearDown()
+	})
+
+	It("panics if the name is empty", func() {
+		Expect(func() {
+			Prefix("", "<desc>").Optional()
+		}).To(PanicWith("invalid specification: variable name must not be empty"))
+	})
+
+	It("panics if the description is empty", func() {
+		Expect(func() {
+			Prefix("FERRITE_PREFIX", "").Optional()
+		}).To(PanicWith("specification for FERRITE_PREFIX is invalid: variable description must not be empty"))
+	})
+
+	When("the variable is required", func() {
+		When("the value is valid", func() {
+			Describe("func Value()", func() {
+				DescribeTable(
+					"it returns the value",
+					func(value, expect string) {
+						os.Setenv("FERRITE_PREFIX", value)
+
+						v := builder.
+							Required().
+							Value()
+
+						Expect(v).To(Equal(netip.MustParsePrefix(expect)))
+					},
+					Entry("IPv4", "192.0.2.0/24", "192.0.2.0/24"),
+					Entry("IPv6", "2001:db8::/32", "2001:db8::/32"),
+					Entry("non-canonical IPv6", "2001:0DB8::0/32", "2001:db8::/32"),
+				)
+			})
+		})
+
+		When("the value is invalid", func() {
+			Describe("func Value()", func() {
+				DescribeTable(
+					"it panics",
+					func(value, expect string) {
+						os.Setenv("FERRITE_PREFIX", value)
+
+						Expect(func() {
+							builder.
+								WithIPv4Only().
+								WithinPrefix("10.0.0.0/8").
+								Required().
+								Value()
+						}).To(PanicWith(expect))
+					},
+					Entry(
+						"invalid syntax",
+						"10.0.0.0",
+						`value of FERRITE_PREFIX (10.0.0.0) is invalid: no '/'`,
+					),
+					Entry(
+						"IPv6 prefix",
+						"2001:db8::/32",
+						`value of FERRITE_PREFIX (2001:db8::/32) is invalid: expected an IPv4 prefix`,
+					),
+					Entry(
+						"prefix outside of prefix",
+						"192.168.0.0/16",
+						`value of FERRITE_PREFIX (192.168.0.0/16) is invalid: expected a prefix within 10.0.0.0/8`,
+					),
+					Entry(
+						"prefix larger than prefix",
+						"10.0.0.0/7",
+						`value of FERRITE_PREFIX (10.0.0.0/7) is invalid: expected a prefix within 10.0.0.0/8`,
+					),
+				)
+			})
+		})
+
+		When("the value is empty", func() {
+			When("there is a default value", func() {
+				Describe("func Value()", func() {
+					It("returns the default", func() {
+						v := builder.
+							WithDefault("192.0.2.0/24").
+							Required().
+							Value()
+
+						Expect(v).To(Equal(netip.MustParsePrefix("192.0.2.0/24")))
+					})
+				})
+			})
+
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("panics", func() {
+						Expect(func() {
+							builder.
+								Required().
+								Value()
+						}).To(PanicWith(
+							"FERRITE_PREFIX is undefined and does not have a default value",
+						))
+					})
+				})
+			})
+		})
+	})
+
+	When("the variable is optional", func() {
+		When("the value is empty", func() {
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("returns with ok == false", func() {
+						_, ok := builder.
+							Optional().
+							Value()
+
+						Expect(ok).To(BeFalse())
+					})
+				})
+			})
+		})
+	})
+})
+
+func ExamplePrefix_required() {
+	defer example()()
+
+	v := ferrite.
+		Prefix("FERRITE_PREFIX", "example prefix variable").
+		Required()
+
+	os.Setenv("FERRITE_PREFIX", "192.0.2.0/24")
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is 192.0.2.0/24
+}
+
+func ExamplePrefix_default() {
+	defer example()()
+
+	v := ferrite.
+		Prefix("FERRITE_PREFIX", "example prefix variable").
+		WithDefault("10.0.0.0/8").
+		Required()
+
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is 10.0.0.0/8
+}
+
+func ExamplePrefix_optional() {
+	defer example()()
+
+	v := ferrite.
+		Prefix("FERRITE_PREFIX", "example prefix variable").
+		Optional()
+
+	ferrite.Init()
+
+	if x, ok := v.Value(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// value is undefined
+}
+
+func ExamplePrefix_deprecated() {
+	defer example()()
+
+	os.Setenv("FERRITE_PREFIX", "2001:0db8::/32")
+	v := ferrite.
+		Prefix("FERRITE_PREFIX", "example prefix variable").
+		Deprecated()
+
+	ferrite.Init()
+
+	if x, ok := v.DeprecatedValue(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// Environment Variables:
+	//
+	//  ❯ FERRITE_PREFIX  example prefix variable  [ <string> ]  ⚠ deprecated variable set to 2001:0db8::/32, equivalent to 2001:db8::/32
+	//
+	// value is 2001:db8::/32
+}
@@ -0,0 +1,146 @@
+package ferrite
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+
+	"github.com/dogmatiq/ferrite/internal/variable"
+)
+
+// Regexp configures an environment variable as a regular expression.
+//
+// The value must use the syntax accepted by [regexp.Compile].
+//
+// name is the name of the environment variable to read. desc is a
+// human-readable description of the environment variable.
+func Regexp(name, desc string) *RegexpBuilder {
+	b := &RegexpBuilder{
+		schema: variable.TypedOther[*regexp.Regexp]{
+			Marshaler: regexpMarshaler{},
+		},
+		examples: []variable.TypedExample[*regexp.Regexp]{
+			{
+				Native:      regexp.MustCompile(`^[a-z]+$`),
+				Description: "matches values consisting only of lowercase letters",
+			},
+		},
+	}
+
+	b.builder.Name(name)
+	b.builder.Description(desc)
+	b.builder.Documentation().
+		Summary("Regular expression syntax").
+		Paragraph(
+			"Regular expressions are specified using the RE2 syntax accepted by Go's `regexp` package.",
+			"The expression is not implicitly anchored; use `^` and `$` to match the entire input.",
+		).
+		Format().
+		Done()
+
+	return b
+}
+
+// RegexpBuilder builds a specification for a regular expression variable.
+type RegexpBuilder struct {
+	schema   variable.TypedOther[*regexp.Regexp]
+	builder  variable.TypedSpecBuilder[*regexp.Regexp]
+	examples []variable.TypedExample[*regexp.Regexp]
+	built    bool
+}
+
+var _ isBuilderOf[*regexp.Regexp, *RegexpBuilder]
+
+// WithDefault sets a default value of the variable.
+//
+// It is used when the environment variable is undefined or empty.
+func (b *RegexpBuilder) WithDefault(v string) *RegexpBuilder {
+	b.builder.Default(regexp.MustCompile(v))
+	return b
+}
+
+// WithConstraint adds a constraint to the variable.
+//
+// fn is called with the compiled regular expression. If it returns false the
+// value is considered invalid.
+func (b *RegexpBuilder) WithConstraint(
+	desc string,
+	fn func(*regexp.Regexp) bool,
+) *RegexpBuilder {
+	b.builder.UserConstraint(desc, fn)
+	return b
+}
+
+// WithConstraintFunc adds a constraint to the variable.
+//
+// It is like [RegexpBuilder.WithConstraint], except that fn returns an error
+// that explains why the expression is invalid, or nil if it is valid.
+func (b *RegexpBuilder) WithConstraintFunc(
+	desc string,
+	fn func(*regexp.Regexp) error,
+) *RegexpBuilder {
+	b.builder.UserConstraintFunc(desc, fn)
+	return b
+}
+
+// Required completes the build process and registers a required variable with
+// Ferrite's validation system.
+func (b *RegexpBuilder) Required(options ...RequiredOption) Required[*regexp.Regexp] {
+	b.build()
+	return required(b.schema, &b.builder, options...)
+}
+
+// Optional completes the build process and registers an optional variable with
+// Ferrite's validation system.
+func (b *RegexpBuilder) Optional(options ...OptionalOption) Optional[*regexp.Regexp] {
+	b.build()
+	return optional(b.schema, &b.builder, options...)
+}
+
+// Deprecated completes the build process and registers a deprecated variable
+// with Ferrite's validation system.
+func (b *RegexpBuilder) Deprecated(options ...DeprecatedOption) Deprecated[*regexp.Regexp] {
+	b.build()
+	return deprecated(b.schema, &b.builder, options...)
+}
+
+func (b *RegexpBuilder) element() (variable.TypedSchema[*regexp.Regexp], *variable.TypedSpecBuilder[*regexp.Regexp]) {
+	b.build()
+	return b.schema, &b.builder
+}
+
+// build adds the examples that meet the variable's constraints, which may be
+// configured in any order.
+func (b *RegexpBuilder) build() {
+	if !b.built {
+		b.built = true
+		addExamples(&b.builder, b.examples...)
+	}
+}
+
+type regexpMarshaler struct{}
+
+func (regexpMarshaler) Marshal(v *regexp.Regexp) (variable.Literal, error) {
+	if v == nil {
+		// The zero value is represented as an empty literal, such as when
+		// describing the conditions of other variables that depend on this one.
+		return variable.Literal{}, nil
+	}
+
+	return variable.Literal{
+		String: v.String(),
+	}, nil
+}
+
+func (regexpMarshaler) Unmarshal(v variable.Literal) (*regexp.Regexp, error) {
+	re, err := regexp.Compile(v.String)
+	if err != nil {
+		// Drop the "error parsing regexp" prefix, which is redundant in the
+		// context of a validation message.
+		return nil, errors.New(
+			strings.TrimPrefix(err.Error(), "error parsing regexp: "),
+		)
+	}
+
+	return re, nil
+}
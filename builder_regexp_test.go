@@ -0,0 +1,188 @@
+package ferrite_test
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("type RegexpBuilder", func() {
+	var builder *RegexpBuilder
+
+	BeforeEach(func() {
+		builder = Regexp("FERRITE_REGEXP", "<desc>")
+	})
+
+	AfterEach(func() {
+		tearDown()
+	})
+
+	It("panics if the name is empty", func() {
+		Expect(func() {
+			Regexp("", "<desc>").Optional()
+		}).To(PanicWith("invalid specification: variable name must not be empty"))
+	})
+
+	It("panics if the description is empty", func() {
+		Expect(func() {
+			Regexp("FERRITE_REGEXP", "").Optional()
+		}).To(PanicWith("specification for FERRITE_REGEXP is invalid: variable description must not be empty"))
+	})
+
+	When("the variable is required", func() {
+		When("the value is valid", func() {
+			Describe("func Value()", func() {
+				It("returns the compiled expression", func() {
+					os.Setenv("FERRITE_REGEXP", `^v[0-9]+$`)
+
+					v := builder.
+						Required().
+						Value()
+
+					Expect(v.String()).To(Equal(`^v[0-9]+$`))
+					Expect(v.MatchString("v2")).To(BeTrue())
+				})
+			})
+		})
+
+		When("the value is invalid", func() {
+			Describe("func Value()", func() {
+				It("panics", func() {
+					os.Setenv("FERRITE_REGEXP", `a(b`)
+
+					Expect(func() {
+						builder.
+							Required().
+							Value()
+					}).To(PanicWith(
+						"value of FERRITE_REGEXP ('a(b') is invalid: missing closing ): `a(b`",
+					))
+				})
+			})
+		})
+
+		When("the value is empty", func() {
+			When("there is a default value", func() {
+				Describe("func Value()", func() {
+					It("returns the default", func() {
+						v := builder.
+							WithDefault(`^[a-z]+$`).
+							Required().
+							Value()
+
+						Expect(v.String()).To(Equal(`^[a-z]+$`))
+					})
+				})
+			})
+
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("panics", func() {
+						Expect(func() {
+							builder.
+								Required().
+								Value()
+						}).To(PanicWith(
+							"FERRITE_REGEXP is undefined and does not have a default value",
+						))
+					})
+				})
+			})
+		})
+	})
+
+	When("the variable is optional", func() {
+		When("the value is empty", func() {
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("returns with ok == false", func() {
+						_, ok := builder.
+							Optional().
+							Value()
+
+						Expect(ok).To(BeFalse())
+					})
+				})
+			})
+		})
+	})
+})
+
+func ExampleRegexp_required() {
+	defer example()()
+
+	v := ferrite.
+		Regexp("FERRITE_REGEXP", "example regular expression variable").
+		Required()
+
+	os.Setenv("FERRITE_REGEXP", `^v[0-9]+$`)
+	ferrite.Init()
+
+	fmt.Println("matches v2:", v.Value().MatchString("v2"))
+
+	// Output:
+	// matches v2: true
+}
+
+func ExampleRegexp_default() {
+	defer example()()
+
+	v := ferrite.
+		Regexp("FERRITE_REGEXP", "example regular expression variable").
+		WithDefault(`^[a-z]+$`).
+		Required()
+
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is ^[a-z]+$
+}
+
+func ExampleRegexp_optional() {
+	defer example()()
+
+	v := ferrite.
+		Regexp("FERRITE_REGEXP", "example regular expression variable").
+		Optional()
+
+	ferrite.Init()
+
+	if x, ok := v.Value(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// value is undefined
+}
+
+func ExampleRegexp_deprecated() {
+	defer example()()
+
+	os.Setenv("FERRITE_REGEXP", `^v[0-9]+$`)
+	v := ferrite.
+		Regexp("FERRITE_REGEXP", "example regular expression variable").
+		Deprecated()
+
+	ferrite.Init()
+
+	if x, ok := v.DeprecatedValue(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// Environment Variables:
+	//
+	//  ❯ FERRITE_REGEXP  example regular expression variable  [ <string> ]  ⚠ deprecated variable set to '^v[0-9]+$'
+	//
+	// value is ^v[0-9]+$
+}
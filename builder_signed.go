@@ -73,6 +73,30 @@ func (b *SignedBuilder[T]) WithMaximum(v T) *SignedBuilder[T] {
 	return b
 }
 
+// WithConstraint adds a constraint to the variable.
+//
+// fn is called with the parsed integer. If it returns false the value is
+// considered invalid.
+func (b *SignedBuilder[T]) WithConstraint(
+	desc string,
+	fn func(T) bool,
+) *SignedBuilder[T] {
+	b.builder.UserConstraint(desc, fn)
+	return b
+}
+
+// WithConstraintFunc adds a constraint to the variable.
+//
+// It is like [SignedBuilder.WithConstraint], except that fn returns an error
+// that explains why the integer is invalid, or nil if it is valid.
+func (b *SignedBuilder[T]) WithConstraintFunc(
+	desc string,
+	fn func(T) error,
+) *SignedBuilder[T] {
+	b.builder.UserConstraintFunc(desc, fn)
+	return b
+}
+
 // Required completes the build process and registers a required variable with
 // Ferrite's validation system.
 func (b *SignedBuilder[T]) Required(options ...RequiredOption) Required[T] {
@@ -91,6 +115,10 @@ func (b *SignedBuilder[T]) Deprecated(options ...DeprecatedOption) Deprecated[T]
 	return deprecated(b.schema, &b.builder, options...)
 }
 
+func (b *SignedBuilder[T]) element() (variable.TypedSchema[T], *variable.TypedSpecBuilder[T]) {
+	return b.schema, &b.builder
+}
+
 type signedMarshaler[T constraints.Signed] struct{}
 
 func (signedMarshaler[T]) Marshal(v T) (variable.Literal, error) {
@@ -0,0 +1,139 @@
+package ferrite
+
+import (
+	"github.com/dogmatiq/ferrite/internal/maybe"
+	"github.com/dogmatiq/ferrite/internal/variable"
+)
+
+// Slice configures an environment variable as a comma-separated list of
+// strings.
+//
+// name is the name of the environment variable to read. desc is a
+// human-readable description of the environment variable.
+func Slice(name, desc string) *SliceBuilder[string] {
+	return SliceOf[string](name, desc, String(name, desc))
+}
+
+// SliceOf configures an environment variable as a comma-separated list of
+// values of type T.
+//
+// name is the name of the environment variable to read. desc is a
+// human-readable description of the environment variable.
+//
+// elem is a builder that describes each element of the slice. It is typically
+// constructed using the same name and description as the slice itself. Any
+// default value configured on elem is ignored.
+func SliceOf[T any](name, desc string, elem Element[T]) *SliceBuilder[T] {
+	spec := buildElement(name, desc, elem)
+
+	b := &SliceBuilder[T]{
+		schema: variable.TypedSlice[T]{
+			ElementSpec: spec,
+			Sep:         ",",
+		},
+	}
+
+	b.builder.Name(name)
+	b.builder.Description(desc)
+
+	inheritElement(&b.builder, spec)
+
+	return b
+}
+
+// SliceBuilder builds a specification for a slice variable.
+type SliceBuilder[T any] struct {
+	schema  variable.TypedSlice[T]
+	builder variable.TypedSpecBuilder[[]T]
+}
+
+var _ isBuilderOf[[]string, *SliceBuilder[string]]
+
+// WithSeparator sets the string used to separate elements within the
+// environment variable's value.
+//
+// The default separator is a comma. Whitespace surrounding each element is
+// ignored.
+func (b *SliceBuilder[T]) WithSeparator(sep string) *SliceBuilder[T] {
+	b.schema.Sep = sep
+	return b
+}
+
+// WithMinimumLength sets the minimum number of elements in the slice.
+func (b *SliceBuilder[T]) WithMinimumLength(n int) *SliceBuilder[T] {
+	b.schema.MinLen = maybe.Some(n)
+	return b
+}
+
+// WithMaximumLength sets the maximum number of elements in the slice.
+func (b *SliceBuilder[T]) WithMaximumLength(n int) *SliceBuilder[T] {
+	b.schema.MaxLen = maybe.Some(n)
+	return b
+}
+
+// WithUniqueElements requires that each element of the slice is distinct.
+//
+// Elements are compared using their canonical representation.
+func (b *SliceBuilder[T]) WithUniqueElements() *SliceBuilder[T] {
+	b.schema.Unique = true
+	return b
+}
+
+// WithDefault sets a default value of the variable.
+//
+// It is used when the environment variable is undefined or empty.
+func (b *SliceBuilder[T]) WithDefault(v ...T) *SliceBuilder[T] {
+	b.builder.Default(v)
+	return b
+}
+
+// WithConstraint adds a constraint to the variable.
+//
+// fn is called with the slice, once all of its elements are parsed. If it
+// returns false the value is considered invalid.
+func (b *SliceBuilder[T]) WithConstraint(
+	desc string,
+	fn func([]T) bool,
+) *SliceBuilder[T] {
+	b.builder.UserConstraint(desc, fn)
+	return b
+}
+
+// WithConstraintFunc adds a constraint to the variable.
+//
+// It is like [SliceBuilder.WithConstraint], except that fn returns an error
+// that explains why the slice is invalid, or nil if it is valid.
+func (b *SliceBuilder[T]) WithConstraintFunc(
+	desc string,
+	fn func([]T) error,
+) *SliceBuilder[T] {
+	b.builder.UserConstraintFunc(desc, fn)
+	return b
+}
+
+// WithSensitiveContent marks the variable as containing sensitive content.
+//
+// Values of sensitive variables are not printed to the console or included in
+// generated documentation.
+func (b *SliceBuilder[T]) WithSensitiveContent() *SliceBuilder[T] {
+	b.builder.MarkSensitive()
+	return b
+}
+
+// Required completes the build process and registers a required variable with
+// Ferrite's validation system.
+func (b *SliceBuilder[T]) Required(options ...RequiredOption) Required[[]T] {
+	return required(b.schema, &b.builder, options...)
+}
+
+// Optional completes the build process and registers an optional variable with
+// Ferrite's validation system.
+func (b *SliceBuilder[T]) Optional(options ...OptionalOption) Optional[[]T] {
+	return optional(b.schema, &b.builder, options...)
+}
+
+// Deprecated completes the build process and registers a deprecated variable
+// with Ferrite's validation system.
+func (b *SliceBuilder[T]) Deprecated(options ...DeprecatedOption) Deprecated[[]T] {
+	return deprecated(b.schema, &b.builder, options...)
+}
@@ -0,0 +1,319 @@
+package ferrite_test
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("type SliceBuilder", func() {
+	var builder *SliceBuilder[uint16]
+
+	BeforeEach(func() {
+		builder = SliceOf[uint16](
+			"FERRITE_SLICE",
+			"<desc>",
+			Unsigned[uint16]("FERRITE_SLICE", "<desc>"),
+		)
+	})
+
+	AfterEach(func() {
+		tearDown()
+	})
+
+	It("panics if the name is empty", func() {
+		Expect(func() {
+			Slice("", "<desc>").Optional()
+		}).To(PanicWith("invalid specification: variable name must not be empty"))
+	})
+
+	It("panics if the description is empty", func() {
+		Expect(func() {
+			Slice("FERRITE_SLICE", "").Optional()
+		}).To(PanicWith("specification for FERRITE_SLICE is invalid: variable description must not be empty"))
+	})
+
+	It("panics if the separator is empty", func() {
+		Expect(func() {
+			Slice("FERRITE_SLICE", "<desc>").
+				WithSeparator(" ").
+				Optional()
+		}).To(PanicWith("specification for FERRITE_SLICE is invalid: separator must not be empty or whitespace"))
+	})
+
+	It("panics if the maximum length is less than the minimum length", func() {
+		Expect(func() {
+			builder.
+				WithMinimumLength(3).
+				WithMaximumLength(2).
+				Optional()
+		}).To(PanicWith("specification for FERRITE_SLICE is invalid: maximum length: must be at least 3"))
+	})
+
+	When("the variable is required", func() {
+		When("the value is valid", func() {
+			Describe("func Value()", func() {
+				DescribeTable(
+					"it returns the value",
+					func(value string, expect []uint16) {
+						os.Setenv("FERRITE_SLICE", value)
+
+						v := builder.
+							Required().
+							Value()
+
+						Expect(v).To(Equal(expect))
+					},
+					Entry("single element", "123", []uint16{123}),
+					Entry("multiple elements", "123,456", []uint16{123, 456}),
+					Entry("whitespace around elements", " 123 , 456 ", []uint16{123, 456}),
+				)
+
+				It("uses the custom separator", func() {
+					os.Setenv("FERRITE_SLICE", "123;456")
+
+					v := builder.
+						WithSeparator(";").
+						Required().
+						Value()
+
+					Expect(v).To(Equal([]uint16{123, 456}))
+				})
+			})
+		})
+
+		When("the value is invalid", func() {
+			Describe("func Value()", func() {
+				DescribeTable(
+					"it panics",
+					func(value, expect string) {
+						os.Setenv("FERRITE_SLICE", value)
+
+						Expect(func() {
+							builder.
+								WithMinimumLength(2).
+								WithMaximumLength(3).
+								WithUniqueElements().
+								Required().
+								Value()
+						}).To(PanicWith(expect))
+					},
+					Entry(
+						"invalid element",
+						"123,-456",
+						`value of FERRITE_SLICE (123,-456) is invalid: element 2 (-456) is invalid: unrecognized uint16 syntax`,
+					),
+					Entry(
+						"empty element",
+						"123,,456",
+						`value of FERRITE_SLICE (123,,456) is invalid: element 2 () is invalid: must not be empty`,
+					),
+					Entry(
+						"too few elements",
+						"123",
+						`value of FERRITE_SLICE (123) is invalid: too short, expected length to be between 2 and 3 elements`,
+					),
+					Entry(
+						"too many elements",
+						"1,2,3,4",
+						`value of FERRITE_SLICE (1,2,3,4) is invalid: too long, expected length to be between 2 and 3 elements`,
+					),
+					Entry(
+						"duplicate elements",
+						"1,2,1",
+						`value of FERRITE_SLICE (1,2,1) is invalid: element 3 (1) is a duplicate of element 1, expected unique elements`,
+					),
+				)
+			})
+		})
+
+		When("the value is empty", func() {
+			When("there is a default value", func() {
+				Describe("func Value()", func() {
+					It("returns the default", func() {
+						v := builder.
+							WithDefault(123, 456).
+							Required().
+							Value()
+
+						Expect(v).To(Equal([]uint16{123, 456}))
+					})
+				})
+			})
+
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("panics", func() {
+						Expect(func() {
+							builder.
+								Required().
+								Value()
+						}).To(PanicWith(
+							"FERRITE_SLICE is undefined and does not have a default value",
+						))
+					})
+				})
+			})
+		})
+	})
+
+	When("the variable is optional", func() {
+		When("the value is empty", func() {
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("returns with ok == false", func() {
+						_, ok := builder.
+							Optional().
+							Value()
+
+						Expect(ok).To(BeFalse())
+					})
+				})
+			})
+		})
+	})
+
+	When("the element builder has constraints", func() {
+		It("applies them to each element", func() {
+			os.Setenv("FERRITE_SLICE", "2000,80")
+
+			Expect(func() {
+				SliceOf[uint16](
+					"FERRITE_SLICE",
+					"<desc>",
+					Unsigned[uint16]("FERRITE_SLICE", "<desc>").
+						WithMinimum(1024),
+				).
+					Required().
+					Value()
+			}).To(PanicWith(
+				`value of FERRITE_SLICE (2000,80) is invalid: element 2 (80) is invalid: too low, expected 1024 or greater`,
+			))
+		})
+	})
+})
+
+func ExampleSlice_required() {
+	defer example()()
+
+	v := ferrite.
+		Slice("FERRITE_SLICE", "example slice variable").
+		Required()
+
+	os.Setenv("FERRITE_SLICE", "foo,bar,baz")
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is [foo bar baz]
+}
+
+func ExampleSlice_default() {
+	defer example()()
+
+	v := ferrite.
+		Slice("FERRITE_SLICE", "example slice variable").
+		WithDefault("foo", "bar").
+		Required()
+
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is [foo bar]
+}
+
+func ExampleSlice_optional() {
+	defer example()()
+
+	v := ferrite.
+		Slice("FERRITE_SLICE", "example slice variable").
+		Optional()
+
+	ferrite.Init()
+
+	if x, ok := v.Value(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// value is undefined
+}
+
+func ExampleSliceOf() {
+	defer example()()
+
+	v := ferrite.SliceOf[uint16](
+		"FERRITE_SLICE",
+		"example slice variable",
+		ferrite.
+			Unsigned[uint16]("FERRITE_SLICE", "example slice variable").
+			WithMinimum(1024),
+	).
+		WithSeparator(";").
+		WithUniqueElements().
+		Required()
+
+	os.Setenv("FERRITE_SLICE", "8080; 8443")
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is [8080 8443]
+}
+
+func ExampleSlice_invalidElement() {
+	defer example()()
+
+	ferrite.SliceOf[uint16](
+		"FERRITE_SLICE",
+		"example slice variable",
+		ferrite.
+			Unsigned[uint16]("FERRITE_SLICE", "example slice variable").
+			WithMinimum(1024),
+	).
+		Required()
+
+	os.Setenv("FERRITE_SLICE", "8080,80")
+	ferrite.Init()
+
+	// Output:
+	// Environment Variables:
+	//
+	//  ❯ FERRITE_SLICE  example slice variable    (1024 ...), ...    ✗ set to 8080,80, element 2 (80) is invalid, too low, expected 1024 or greater
+	//
+	// <process exited with error code 1>
+}
+
+func ExampleSlice_deprecated() {
+	defer example()()
+
+	os.Setenv("FERRITE_SLICE", "foo, bar")
+	v := ferrite.
+		Slice("FERRITE_SLICE", "example slice variable").
+		Deprecated()
+
+	ferrite.Init()
+
+	if x, ok := v.DeprecatedValue(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// Environment Variables:
+	//
+	//  ❯ FERRITE_SLICE  example slice variable  [ <string>, ... ]  ⚠ deprecated variable set to 'foo, bar', equivalent to foo,bar
+	//
+	// value is [foo bar]
+}
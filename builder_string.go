@@ -1,6 +1,9 @@
 package ferrite
 
 import (
+	"regexp"
+
+	"github.com/dogmatiq/ferrite/internal/maybe"
 	"github.com/dogmatiq/ferrite/internal/variable"
 )
 
@@ -42,6 +45,40 @@ func (b *StringBuilder[T]) WithDefault(v T) *StringBuilder[T] {
 	return b
 }
 
+// WithPattern requires the variable's value to match a regular expression.
+//
+// pattern uses the syntax accepted by [regexp.Compile]. It is not implicitly
+// anchored, use "^" and "$" to match the entire value.
+func (b *StringBuilder[T]) WithPattern(pattern string) *StringBuilder[T] {
+	b.schema.Regexp = regexp.MustCompile(pattern)
+	return b
+}
+
+// WithMinimumLength sets the minimum length of the variable's value, in bytes.
+//
+// The length of a string is measured in bytes, not characters.
+func (b *StringBuilder[T]) WithMinimumLength(n int) *StringBuilder[T] {
+	b.schema.MinLen = maybe.Some(n)
+	return b
+}
+
+// WithMaximumLength sets the maximum length of the variable's value, in bytes.
+//
+// The length of a string is measured in bytes, not characters.
+func (b *StringBuilder[T]) WithMaximumLength(n int) *StringBuilder[T] {
+	b.schema.MaxLen = maybe.Some(n)
+	return b
+}
+
+// WithLength sets the exact length of the variable's value, in bytes.
+//
+// The length of a string is measured in bytes, not characters.
+func (b *StringBuilder[T]) WithLength(n int) *StringBuilder[T] {
+	b.schema.MinLen = maybe.Some(n)
+	b.schema.MaxLen = maybe.Some(n)
+	return b
+}
+
 // WithConstraint adds a constraint to the variable.
 //
 // fn is called with the environment variable value after it is parsed. If fn
@@ -54,6 +91,18 @@ func (b *StringBuilder[T]) WithConstraint(
 	return b
 }
 
+// WithConstraintFunc adds a constraint to the variable.
+//
+// It is like [StringBuilder.WithConstraint], except that fn returns an error
+// that explains why the string is invalid, or nil if it is valid.
+func (b *StringBuilder[T]) WithConstraintFunc(
+	desc string,
+	fn func(T) error,
+) *StringBuilder[T] {
+	b.builder.UserConstraintFunc(desc, fn)
+	return b
+}
+
 // WithSensitiveContent marks the variable as containing sensitive content.
 //
 // Values of sensitive variables are not printed to the console or included in
@@ -80,3 +129,7 @@ func (b *StringBuilder[T]) Optional(options ...OptionalOption) Optional[T] {
 func (b *StringBuilder[T]) Deprecated(options ...DeprecatedOption) Deprecated[T] {
 	return deprecated(b.schema, &b.builder, options...)
 }
+
+func (b *StringBuilder[T]) element() (variable.TypedSchema[T], *variable.TypedSpecBuilder[T]) {
+	return b.schema, &b.builder
+}
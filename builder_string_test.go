@@ -35,6 +35,78 @@ var _ = Describe("type StringBuilder", func() {
 		}).To(PanicWith("specification for FERRITE_STRING is invalid: variable description must not be empty"))
 	})
 
+	It("panics if the minimum length is less than one", func() {
+		Expect(func() {
+			builder.
+				WithMinimumLength(0).
+				Optional()
+		}).To(PanicWith("specification for FERRITE_STRING is invalid: minimum length: must be at least 1"))
+	})
+
+	It("panics if the maximum length is less than the minimum length", func() {
+		Expect(func() {
+			builder.
+				WithMinimumLength(10).
+				WithMaximumLength(5).
+				Optional()
+		}).To(PanicWith("specification for FERRITE_STRING is invalid: maximum length: must be at least 10"))
+	})
+
+	When("the variable has length limits", func() {
+		Describe("func Value()", func() {
+			It("returns the value if it is within the limits", func() {
+				os.Setenv("FERRITE_STRING", "abcdefg")
+
+				v := builder.
+					WithMinimumLength(5).
+					WithMaximumLength(10).
+					Required().
+					Value()
+
+				Expect(v).To(Equal(userDefinedString("abcdefg")))
+			})
+
+			DescribeTable(
+				"it panics if the value is outside the limits",
+				func(configure func(*StringBuilder[userDefinedString]), value, expect string) {
+					os.Setenv("FERRITE_STRING", value)
+
+					configure(builder)
+
+					Expect(func() {
+						builder.
+							Required().
+							Value()
+					}).To(PanicWith(expect))
+				},
+				Entry(
+					"too short",
+					func(b *StringBuilder[userDefinedString]) { b.WithMinimumLength(5) },
+					"abcd",
+					`value of FERRITE_STRING (abcd) is invalid: too short, expected length to be 5 bytes or more`,
+				),
+				Entry(
+					"too long",
+					func(b *StringBuilder[userDefinedString]) { b.WithMaximumLength(5) },
+					"abcdefgh",
+					`value of FERRITE_STRING (abcdefgh) is invalid: too long, expected length to be 5 bytes or fewer`,
+				),
+				Entry(
+					"not the exact length",
+					func(b *StringBuilder[userDefinedString]) { b.WithLength(5) },
+					"abcdefgh",
+					`value of FERRITE_STRING (abcdefgh) is invalid: too long, expected length to be exactly 5 bytes`,
+				),
+			)
+		})
+	})
+
+	It("panics if the pattern is invalid", func() {
+		Expect(func() {
+			builder.WithPattern("(")
+		}).To(PanicWith("regexp: Compile(`(`): error parsing regexp: missing closing ): `(`"))
+	})
+
 	When("the variable is required", func() {
 		When("the value is not empty", func() {
 			Describe("func Value()", func() {
@@ -50,6 +122,38 @@ var _ = Describe("type StringBuilder", func() {
 			})
 		})
 
+		When("the value matches the pattern", func() {
+			Describe("func Value()", func() {
+				It("returns the value", func() {
+					os.Setenv("FERRITE_STRING", "abc-123")
+
+					v := builder.
+						WithPattern(`^[a-z]+-[0-9]+$`).
+						Required().
+						Value()
+
+					Expect(v).To(Equal(userDefinedString("abc-123")))
+				})
+			})
+		})
+
+		When("the value does not match the pattern", func() {
+			Describe("func Value()", func() {
+				It("panics", func() {
+					os.Setenv("FERRITE_STRING", "ABC-123")
+
+					Expect(func() {
+						builder.
+							WithPattern(`^[a-z]+-[0-9]+$`).
+							Required().
+							Value()
+					}).To(PanicWith(
+						"value of FERRITE_STRING (ABC-123) is invalid: expected a value matching the pattern ^[a-z]+-[0-9]+$",
+					))
+				})
+			})
+		})
+
 		When("the value is empty", func() {
 			When("there is a default value", func() {
 				Describe("func Value()", func() {
@@ -177,6 +281,41 @@ func ExampleString_optional() {
 	// value is undefined
 }
 
+func ExampleString_length() {
+	defer example()()
+
+	v := ferrite.
+		String("FERRITE_STRING", "example string variable").
+		WithMinimumLength(5).
+		WithMaximumLength(10).
+		Required()
+
+	os.Setenv("FERRITE_STRING", "<value>")
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is <value>
+}
+
+func ExampleString_pattern() {
+	defer example()()
+
+	v := ferrite.
+		String("FERRITE_STRING", "example string variable").
+		WithPattern(`^[a-z]+-[0-9]+$`).
+		Required()
+
+	os.Setenv("FERRITE_STRING", "abc-123")
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is abc-123
+}
+
 func ExampleString_sensitive() {
 	defer example()()
 
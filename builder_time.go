@@ -0,0 +1,307 @@
+package ferrite
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dogmatiq/ferrite/internal/maybe"
+	"github.com/dogmatiq/ferrite/internal/variable"
+)
+
+// Time configures an environment variable as a point in time.
+//
+// By default the value must be specified in RFC 3339 format, such as
+// "2006-01-02T15:04:05Z". Use [TimeBuilder.WithLayout],
+// [TimeBuilder.WithUnixSeconds] or [TimeBuilder.WithUnixMilliseconds] to
+// accept other formats.
+//
+// name is the name of the environment variable to read. desc is a
+// human-readable description of the environment variable.
+func Time(name, desc string) *TimeBuilder {
+	b := &TimeBuilder{
+		marshaler: timeMarshaler{
+			Layout: time.RFC3339,
+		},
+	}
+
+	b.schema.Check = func() error {
+		return b.limits().Check()
+	}
+
+	b.builder.Name(name)
+	b.builder.Description(desc)
+
+	return b
+}
+
+// TimeBuilder builds a specification for a time variable.
+type TimeBuilder struct {
+	schema    variable.TypedOther[time.Time]
+	builder   variable.TypedSpecBuilder[time.Time]
+	marshaler timeMarshaler
+	min, max  maybe.Value[time.Time]
+	built     bool
+}
+
+var _ isBuilderOf[time.Time, *TimeBuilder]
+
+// WithLayout sets the layout used to parse and format the variable's value.
+//
+// layout is a layout string as accepted by [time.Parse], such as
+// [time.RFC1123] or "2006-01-02". Values that do not include a time zone are
+// interpreted as UTC.
+func (b *TimeBuilder) WithLayout(layout string) *TimeBuilder {
+	b.marshaler = timeMarshaler{
+		Layout: layout,
+	}
+	return b
+}
+
+// WithUnixSeconds configures the variable to accept values specified as the
+// number of seconds since the Unix epoch.
+func (b *TimeBuilder) WithUnixSeconds() *TimeBuilder {
+	b.marshaler = timeMarshaler{
+		Unit: time.Second,
+	}
+	return b
+}
+
+// WithUnixMilliseconds configures the variable to accept values specified as
+// the number of milliseconds since the Unix epoch.
+func (b *TimeBuilder) WithUnixMilliseconds() *TimeBuilder {
+	b.marshaler = timeMarshaler{
+		Unit: time.Millisecond,
+	}
+	return b
+}
+
+// WithDefault sets a default value of the variable.
+//
+// It is used when the environment variable is undefined or empty.
+func (b *TimeBuilder) WithDefault(v time.Time) *TimeBuilder {
+	b.builder.Default(v)
+	return b
+}
+
+// WithMinimum sets the earliest acceptable value of the variable.
+func (b *TimeBuilder) WithMinimum(v time.Time) *TimeBuilder {
+	b.min = maybe.Some(v)
+	return b
+}
+
+// WithMaximum sets the latest acceptable value of the variable.
+func (b *TimeBuilder) WithMaximum(v time.Time) *TimeBuilder {
+	b.max = maybe.Some(v)
+	return b
+}
+
+// WithConstraint adds a constraint to the variable.
+//
+// fn is called with the parsed time. If it returns false the value is
+// considered invalid.
+func (b *TimeBuilder) WithConstraint(
+	desc string,
+	fn func(time.Time) bool,
+) *TimeBuilder {
+	b.builder.UserConstraint(desc, fn)
+	return b
+}
+
+// WithConstraintFunc adds a constraint to the variable.
+//
+// It is like [TimeBuilder.WithConstraint], except that fn returns an error that
+// explains why the time is invalid, or nil if it is valid.
+func (b *TimeBuilder) WithConstraintFunc(
+	desc string,
+	fn func(time.Time) error,
+) *TimeBuilder {
+	b.builder.UserConstraintFunc(desc, fn)
+	return b
+}
+
+// Required completes the build process and registers a required variable with
+// Ferrite's validation system.
+func (b *TimeBuilder) Required(options ...RequiredOption) Required[time.Time] {
+	b.build()
+	return required(b.schema, &b.builder, options...)
+}
+
+// Optional completes the build process and registers an optional variable with
+// Ferrite's validation system.
+func (b *TimeBuilder) Optional(options ...OptionalOption) Optional[time.Time] {
+	b.build()
+	return optional(b.schema, &b.builder, options...)
+}
+
+// Deprecated completes the build process and registers a deprecated variable
+// with Ferrite's validation system.
+func (b *TimeBuilder) Deprecated(options ...DeprecatedOption) Deprecated[time.Time] {
+	b.build()
+	return deprecated(b.schema, &b.builder, options...)
+}
+
+func (b *TimeBuilder) element() (variable.TypedSchema[time.Time], *variable.TypedSpecBuilder[time.Time]) {
+	b.build()
+	return b.schema, &b.builder
+}
+
+// build adds the constraints, examples and documentation that depend on the
+// layout and limits of the variable, which may be configured in any order.
+func (b *TimeBuilder) build() {
+	if b.built {
+		return
+	}
+	b.built = true
+
+	b.schema.Marshaler = b.marshaler
+	b.limits().Constrain(&b.builder)
+
+	min, hasMin := b.min.Get()
+	max, hasMax := b.max.Get()
+
+	if hasMin {
+		addExamples(&b.builder, variable.TypedExample[time.Time]{
+			Native:      min,
+			Description: "the earliest permitted time",
+			IsNormative: true,
+		})
+	}
+
+	if hasMax {
+		addExamples(&b.builder, variable.TypedExample[time.Time]{
+			Native:      max,
+			Description: "the latest permitted time",
+			IsNormative: true,
+		})
+	}
+
+	if !hasMin && !hasMax {
+		addExamples(&b.builder, variable.TypedExample[time.Time]{
+			Native: time.Date(2024, 1, 1, 9, 30, 0, 0, time.UTC),
+		})
+	}
+
+	b.marshaler.buildDocumentation(b.builder.Documentation())
+}
+
+// limits returns the limits of the variable's value.
+func (b *TimeBuilder) limits() rangeLimits[time.Time] {
+	return rangeLimits[time.Time]{
+		Min:     b.min,
+		Max:     b.max,
+		Less:    time.Time.Before,
+		Format:  b.marshaler.format,
+		Lower:   "earlier",
+		Higher:  "later",
+		TooLow:  "early",
+		TooHigh: "late",
+	}
+}
+
+type timeMarshaler struct {
+	// Layout is the layout used to parse and format the time. If it is empty
+	// the time is represented as a Unix timestamp in the given unit.
+	Layout string
+	Unit   time.Duration
+}
+
+func (m timeMarshaler) Marshal(v time.Time) (variable.Literal, error) {
+	return variable.Literal{
+		String: m.format(v),
+	}, nil
+}
+
+func (m timeMarshaler) Unmarshal(v variable.Literal) (time.Time, error) {
+	if m.Layout == "" {
+		n, err := strconv.ParseInt(v.String, 10, 64)
+		if err != nil {
+			return time.Time{}, errors.New("expected an integer Unix timestamp")
+		}
+
+		if m.Unit == time.Millisecond {
+			return time.UnixMilli(n).UTC(), nil
+		}
+		return time.Unix(n, 0).UTC(), nil
+	}
+
+	t, err := time.Parse(m.Layout, v.String)
+	if err != nil {
+		var parseErr *time.ParseError
+		if errors.As(err, &parseErr) && parseErr.Message != "" {
+			return time.Time{}, errors.New(strings.TrimPrefix(parseErr.Message, ": "))
+		}
+
+		return time.Time{}, fmt.Errorf("expected a time in %s format", m.name())
+	}
+
+	return t, nil
+}
+
+// format returns the literal representation of v.
+func (m timeMarshaler) format(v time.Time) string {
+	switch {
+	case m.Layout == time.RFC3339:
+		// RFC 3339 allows fractional seconds, which would otherwise be lost.
+		return v.Format(time.RFC3339Nano)
+	case m.Layout != "":
+		return v.Format(m.Layout)
+	case m.Unit == time.Millisecond:
+		return strconv.FormatInt(v.UnixMilli(), 10)
+	default:
+		return strconv.FormatInt(v.Unix(), 10)
+	}
+}
+
+// name returns a human-readable name for the format.
+func (m timeMarshaler) name() string {
+	switch {
+	case m.Layout == time.RFC3339:
+		return "RFC 3339"
+	case m.Layout != "":
+		return fmt.Sprintf("%q", m.Layout)
+	case m.Unit == time.Millisecond:
+		return "Unix millisecond"
+	default:
+		return "Unix second"
+	}
+}
+
+func (m timeMarshaler) buildDocumentation(d variable.DocumentationBuilder) {
+	d = d.Summary("Time syntax")
+
+	switch {
+	case m.Layout == time.RFC3339:
+		d.Paragraph(
+			"Times are specified in RFC 3339 format, such as `2006-01-02T15:04:05Z` or `2006-01-02T15:04:05+10:00`.",
+			"The seconds may include an **OPTIONAL** fractional part, such as `2006-01-02T15:04:05.999Z`.",
+		).
+			Format().
+			Done()
+
+	case m.Layout != "":
+		d.Paragraph(
+			"Times are specified according to the `%s` layout,",
+			"as described by the [Go `time` package](https://pkg.go.dev/time#pkg-constants).",
+			"Times that do not include a time zone are interpreted as UTC.",
+		).
+			Format(m.Layout).
+			Done()
+
+	case m.Unit == time.Millisecond:
+		d.Paragraph(
+			"Times are specified as the number of milliseconds since the Unix epoch, `1970-01-01T00:00:00Z`.",
+		).
+			Format().
+			Done()
+
+	default:
+		d.Paragraph(
+			"Times are specified as the number of seconds since the Unix epoch, `1970-01-01T00:00:00Z`.",
+		).
+			Format().
+			Done()
+	}
+}
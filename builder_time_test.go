@@ -0,0 +1,323 @@
+package ferrite_test
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("type TimeBuilder", func() {
+	var builder *TimeBuilder
+
+	BeforeEach(func() {
+		builder = Time("FERRITE_TIME", "<desc>")
+	})
+
+	AfterEach(func() {
+		tearDown()
+	})
+
+	It("panics if the name is empty", func() {
+		Expect(func() {
+			Time("", "<desc>").Optional()
+		}).To(PanicWith("invalid specification: variable name must not be empty"))
+	})
+
+	It("panics if the description is empty", func() {
+		Expect(func() {
+			Time("FERRITE_TIME", "").Optional()
+		}).To(PanicWith("specification for FERRITE_TIME is invalid: variable description must not be empty"))
+	})
+
+	It("panics if the maximum is earlier than the minimum", func() {
+		Expect(func() {
+			builder.
+				WithMinimum(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)).
+				WithMaximum(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)).
+				Optional()
+		}).To(PanicWith("specification for FERRITE_TIME is invalid: maximum must not be earlier than the minimum"))
+	})
+
+	It("panics if the default value is outside the limits", func() {
+		Expect(func() {
+			builder.
+				WithMinimum(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)).
+				WithDefault(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)).
+				Optional()
+		}).To(PanicWith("specification for FERRITE_TIME is invalid: default value: too early, expected 2024-01-01T00:00:00Z or later"))
+	})
+
+	When("the variable is required", func() {
+		When("the value is valid", func() {
+			Describe("func Value()", func() {
+				DescribeTable(
+					"it returns the value",
+					func(configure func(*TimeBuilder), value string, expect time.Time) {
+						os.Setenv("FERRITE_TIME", value)
+
+						configure(builder)
+
+						v := builder.
+							Required().
+							Value()
+
+						Expect(v.Equal(expect)).To(BeTrue(), "expected %s, got %s", expect, v)
+					},
+					Entry(
+						"RFC 3339",
+						func(*TimeBuilder) {},
+						"2024-01-01T09:30:00Z",
+						time.Date(2024, 1, 1, 9, 30, 0, 0, time.UTC),
+					),
+					Entry(
+						"RFC 3339 with fractional seconds and offset",
+						func(*TimeBuilder) {},
+						"2024-01-01T19:30:00.5+10:00",
+						time.Date(2024, 1, 1, 9, 30, 0, 500000000, time.UTC),
+					),
+					Entry(
+						"custom layout",
+						func(b *TimeBuilder) { b.WithLayout("2006-01-02") },
+						"2024-01-01",
+						time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+					),
+					Entry(
+						"Unix seconds",
+						func(b *TimeBuilder) { b.WithUnixSeconds() },
+						"1704101400",
+						time.Date(2024, 1, 1, 9, 30, 0, 0, time.UTC),
+					),
+					Entry(
+						"Unix milliseconds",
+						func(b *TimeBuilder) { b.WithUnixMilliseconds() },
+						"1704101400500",
+						time.Date(2024, 1, 1, 9, 30, 0, 500000000, time.UTC),
+					),
+				)
+			})
+		})
+
+		When("the value is invalid", func() {
+			Describe("func Value()", func() {
+				DescribeTable(
+					"it panics",
+					func(configure func(*TimeBuilder), value, expect string) {
+						os.Setenv("FERRITE_TIME", value)
+
+						configure(builder)
+
+						Expect(func() {
+							builder.
+								Required().
+								Value()
+						}).To(PanicWith(expect))
+					},
+					Entry(
+						"invalid syntax",
+						func(*TimeBuilder) {},
+						"2024-01-01",
+						`value of FERRITE_TIME (2024-01-01) is invalid: expected a time in RFC 3339 format`,
+					),
+					Entry(
+						"out of range component",
+						func(*TimeBuilder) {},
+						"2024-13-01T00:00:00Z",
+						`value of FERRITE_TIME (2024-13-01T00:00:00Z) is invalid: month out of range`,
+					),
+					Entry(
+						"invalid syntax for custom layout",
+						func(b *TimeBuilder) { b.WithLayout("2006-01-02") },
+						"01/02/2024",
+						`value of FERRITE_TIME (01/02/2024) is invalid: expected a time in "2006-01-02" format`,
+					),
+					Entry(
+						"invalid Unix timestamp",
+						func(b *TimeBuilder) { b.WithUnixSeconds() },
+						"1.5",
+						`value of FERRITE_TIME (1.5) is invalid: expected an integer Unix timestamp`,
+					),
+					Entry(
+						"earlier than the minimum",
+						func(b *TimeBuilder) {
+							b.WithMinimum(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+						},
+						"2023-12-31T23:59:59Z",
+						`value of FERRITE_TIME (2023-12-31T23:59:59Z) is invalid: too early, expected 2024-01-01T00:00:00Z or later`,
+					),
+					Entry(
+						"later than the maximum",
+						func(b *TimeBuilder) {
+							b.
+								WithMinimum(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)).
+								WithMaximum(time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC))
+						},
+						"2025-01-01T00:00:00Z",
+						`value of FERRITE_TIME (2025-01-01T00:00:00Z) is invalid: too late, expected between 2024-01-01T00:00:00Z and 2024-12-31T00:00:00Z`,
+					),
+				)
+			})
+		})
+
+		When("the value is empty", func() {
+			When("there is a default value", func() {
+				Describe("func Value()", func() {
+					It("returns the default", func() {
+						expect := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+						v := builder.
+							WithDefault(expect).
+							Required().
+							Value()
+
+						Expect(v).To(Equal(expect))
+					})
+				})
+			})
+
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("panics", func() {
+						Expect(func() {
+							builder.
+								Required().
+								Value()
+						}).To(PanicWith(
+							"FERRITE_TIME is undefined and does not have a default value",
+						))
+					})
+				})
+			})
+		})
+	})
+
+	When("the variable is optional", func() {
+		When("the value is empty", func() {
+			When("there is no default value", func() {
+				Describe("func Value()", func() {
+					It("returns with ok == false", func() {
+						_, ok := builder.
+							Optional().
+							Value()
+
+						Expect(ok).To(BeFalse())
+					})
+				})
+			})
+		})
+	})
+})
+
+func ExampleTime_required() {
+	defer example()()
+
+	v := ferrite.
+		Time("FERRITE_TIME", "example time variable").
+		Required()
+
+	os.Setenv("FERRITE_TIME", "2024-01-01T09:30:00Z")
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is 2024-01-01 09:30:00 +0000 UTC
+}
+
+func ExampleTime_default() {
+	defer example()()
+
+	v := ferrite.
+		Time("FERRITE_TIME", "example time variable").
+		WithDefault(time.Date(2024, 1, 1, 9, 30, 0, 0, time.UTC)).
+		Required()
+
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is 2024-01-01 09:30:00 +0000 UTC
+}
+
+func ExampleTime_optional() {
+	defer example()()
+
+	v := ferrite.
+		Time("FERRITE_TIME", "example time variable").
+		Optional()
+
+	ferrite.Init()
+
+	if x, ok := v.Value(); ok {
+		fmt.Println("value is", x)
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// value is undefined
+}
+
+func ExampleTime_layout() {
+	defer example()()
+
+	v := ferrite.
+		Time("FERRITE_TIME", "example time variable").
+		WithLayout("2006-01-02").
+		WithMinimum(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)).
+		Required()
+
+	os.Setenv("FERRITE_TIME", "2024-06-30")
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is 2024-06-30 00:00:00 +0000 UTC
+}
+
+func ExampleTime_unixSeconds() {
+	defer example()()
+
+	v := ferrite.
+		Time("FERRITE_TIME", "example time variable").
+		WithUnixSeconds().
+		Required()
+
+	os.Setenv("FERRITE_TIME", "1704101400")
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is 2024-01-01 09:30:00 +0000 UTC
+}
+
+func ExampleTime_deprecated() {
+	defer example()()
+
+	os.Setenv("FERRITE_TIME", "2024-01-01T19:30:00.000+10:00")
+	v := ferrite.
+		Time("FERRITE_TIME", "example time variable").
+		Deprecated()
+
+	ferrite.Init()
+
+	if x, ok := v.DeprecatedValue(); ok {
+		fmt.Println("value is", x.UTC())
+	} else {
+		fmt.Println("value is undefined")
+	}
+
+	// Output:
+	// Environment Variables:
+	//
+	//  ❯ FERRITE_TIME  example time variable  [ <string> ]  ⚠ deprecated variable set to 2024-01-01T19:30:00.000+10:00, equivalent to 2024-01-01T19:30:00+10:00
+	//
+	// value is 2024-01-01 09:30:00 +0000 UTC
+}
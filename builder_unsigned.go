@@ -72,6 +72,30 @@ func (b *UnsignedBuilder[T]) WithMaximum(v T) *UnsignedBuilder[T] {
 	return b
 }
 
+// WithConstraint adds a constraint to the variable.
+//
+// fn is called with the parsed integer. If it returns false the value is
+// considered invalid.
+func (b *UnsignedBuilder[T]) WithConstraint(
+	desc string,
+	fn func(T) bool,
+) *UnsignedBuilder[T] {
+	b.builder.UserConstraint(desc, fn)
+	return b
+}
+
+// WithConstraintFunc adds a constraint to the variable.
+//
+// It is like [UnsignedBuilder.WithConstraint], except that fn returns an error
+// that explains why the integer is invalid, or nil if it is valid.
+func (b *UnsignedBuilder[T]) WithConstraintFunc(
+	desc string,
+	fn func(T) error,
+) *UnsignedBuilder[T] {
+	b.builder.UserConstraintFunc(desc, fn)
+	return b
+}
+
 // Required completes the build process and registers a required variable with
 // Ferrite's validation system.
 func (b *UnsignedBuilder[T]) Required(options ...RequiredOption) Required[T] {
@@ -90,6 +114,10 @@ func (b *UnsignedBuilder[T]) Deprecated(options ...DeprecatedOption) Deprecated[
 	return deprecated(b.schema, &b.builder, options...)
 }
 
+func (b *UnsignedBuilder[T]) element() (variable.TypedSchema[T], *variable.TypedSpecBuilder[T]) {
+	return b.schema, &b.builder
+}
+
 type unsignedMarshaler[T constraints.Unsigned] struct{}
 
 func (unsignedMarshaler[T]) Marshal(v T) (variable.Literal, error) {
@@ -69,6 +69,30 @@ func (b *URLBuilder) WithDefault(v string) *URLBuilder {
 	return b
 }
 
+// WithConstraint adds a constraint to the variable.
+//
+// fn is called with the parsed URL. If it returns false the value is considered
+// invalid.
+func (b *URLBuilder) WithConstraint(
+	desc string,
+	fn func(*url.URL) bool,
+) *URLBuilder {
+	b.builder.UserConstraint(desc, fn)
+	return b
+}
+
+// WithConstraintFunc adds a constraint to the variable.
+//
+// It is like [URLBuilder.WithConstraint], except that fn returns an error that
+// explains why the URL is invalid, or nil if it is valid.
+func (b *URLBuilder) WithConstraintFunc(
+	desc string,
+	fn func(*url.URL) error,
+) *URLBuilder {
+	b.builder.UserConstraintFunc(desc, fn)
+	return b
+}
+
 // Required completes the build process and registers a required variable with
 // Ferrite's validation system.
 func (b *URLBuilder) Required(options ...RequiredOption) Required[*url.URL] {
@@ -87,6 +111,10 @@ func (b *URLBuilder) Deprecated(options ...DeprecatedOption) Deprecated[*url.URL
 	return deprecated(b.schema, &b.builder, options...)
 }
 
+func (b *URLBuilder) element() (variable.TypedSchema[*url.URL], *variable.TypedSpecBuilder[*url.URL]) {
+	return b.schema, &b.builder
+}
+
 type urlMarshaler struct{}
 
 func (urlMarshaler) Marshal(v *url.URL) (variable.Literal, error) {
@@ -0,0 +1,174 @@
+package ferrite_test
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"net/url"
+	"os"
+	"regexp"
+	"time"
+
+	. "github.com/dogmatiq/ferrite"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("user-defined constraints", func() {
+	AfterEach(func() {
+		tearDown()
+	})
+
+	Describe("func Value()", func() {
+		DescribeTable(
+			"it returns the value if it satisfies the constraint",
+			func(c constraintCase) {
+				os.Setenv("FERRITE_CONSTRAINT", c.Literal)
+
+				var arg any
+				v := c.Bool(func(v any) bool {
+					arg = v
+					return true
+				})()
+
+				Expect(arg).NotTo(BeNil())
+				Expect(v).To(Equal(arg))
+			},
+			constraintEntries,
+		)
+
+		DescribeTable(
+			"it panics if the value does not satisfy a boolean constraint",
+			func(c constraintCase) {
+				os.Setenv("FERRITE_CONSTRAINT", c.Literal)
+
+				// The constraint only rejects values once the variable is
+				// built, so that it does not reject the builder's examples.
+				reject := false
+				value := c.Bool(func(any) bool {
+					return !reject
+				})
+				reject = true
+
+				Expect(func() {
+					value()
+				}).To(PanicWith(
+					fmt.Sprintf(
+						"value of FERRITE_CONSTRAINT (%s) is invalid: <constraint>",
+						c.Literal,
+					),
+				))
+			},
+			constraintEntries,
+		)
+
+		DescribeTable(
+			"it panics with the constraint's error message if the value does not satisfy a constraint function",
+			func(c constraintCase) {
+				os.Setenv("FERRITE_CONSTRAINT", c.Literal)
+
+				reject := false
+				value := c.Func(func(any) error {
+					if reject {
+						return errors.New("<reason>")
+					}
+					return nil
+				})
+				reject = true
+
+				Expect(func() {
+					value()
+				}).To(PanicWith(
+					fmt.Sprintf(
+						"value of FERRITE_CONSTRAINT (%s) is invalid: <reason>",
+						c.Literal,
+					),
+				))
+			},
+			constraintEntries,
+		)
+	})
+})
+
+var constraintEntries = []TableEntry{
+	Entry("address", constraintEntry[NetworkAddress]("localhost:8080", Address)),
+	Entry("binary", constraintEntry[[]byte]("aGVsbG8=", Binary)),
+	Entry("bool", constraintEntry[bool]("true", Bool)),
+	Entry("byte size", constraintEntry[uint64]("1KiB", ByteSize)),
+	Entry("duration", constraintEntry[time.Duration]("1s", Duration)),
+	Entry("enum", constraintEntry[string]("red", func(name, desc string) *EnumBuilder[string] {
+		return Enum(name, desc).WithMembers("red", "green")
+	})),
+	Entry("file", constraintEntry[FileName]("/path/to/file", File)),
+	Entry("float", constraintEntry[float64]("1.5", Float[float64])),
+	Entry("IP address", constraintEntry[netip.Addr]("192.0.2.1", IPAddr)),
+	Entry("location", constraintEntry[*time.Location]("UTC", Location)),
+	Entry("map", constraintEntry[map[string]string]("a=1", Map)),
+	Entry("network port", constraintEntry[string]("8080", NetworkPort)),
+	Entry("prefix", constraintEntry[netip.Prefix]("192.0.2.0/24", Prefix)),
+	Entry("regexp", constraintEntry[*regexp.Regexp]("a+", Regexp)),
+	Entry("signed", constraintEntry[int]("-1", Signed[int])),
+	Entry("slice", constraintEntry[[]string]("a,b", Slice)),
+	Entry("string", constraintEntry[string]("foo", String)),
+	Entry("time", constraintEntry[time.Time]("2006-01-02T15:04:05Z", Time)),
+	Entry("unsigned", constraintEntry[uint]("1", Unsigned[uint])),
+	Entry("URL", constraintEntry[*url.URL]("https://example.org", URL)),
+}
+
+// constraintCase describes how to exercise the user-defined constraints of a
+// specific builder.
+type constraintCase struct {
+	// Literal is a valid value for the variable.
+	Literal string
+
+	// Bool builds a required variable that has a boolean constraint that
+	// calls fn. It returns a function that returns the variable's value.
+	Bool func(fn func(any) bool) func() any
+
+	// Func builds a required variable that has a constraint function that
+	// calls fn. It returns a function that returns the variable's value.
+	Func func(fn func(any) error) func() any
+}
+
+// constraintEntry returns a [constraintCase] for the builders returned by
+// newBuilder.
+func constraintEntry[T any, B interface {
+	WithConstraint(string, func(T) bool) B
+	WithConstraintFunc(string, func(T) error) B
+	Required(...RequiredOption) Required[T]
+}](
+	literal string,
+	newBuilder func(name, desc string) B,
+) constraintCase {
+	return constraintCase{
+		Literal: literal,
+		Bool: func(fn func(any) bool) func() any {
+			v := newBuilder("FERRITE_CONSTRAINT", "<desc>").
+				WithConstraint(
+					"<constraint>",
+					func(v T) bool {
+						return fn(v)
+					},
+				).
+				Required()
+
+			return func() any {
+				return v.Value()
+			}
+		},
+		Func: func(fn func(any) error) func() any {
+			v := newBuilder("FERRITE_CONSTRAINT", "<desc>").
+				WithConstraintFunc(
+					"<constraint>",
+					func(v T) error {
+						return fn(v)
+					},
+				).
+				Required()
+
+			return func() any {
+				return v.Value()
+			}
+		},
+	}
+}
@@ -0,0 +1,65 @@
+package ferrite
+
+import (
+	"fmt"
+
+	"github.com/dogmatiq/ferrite/internal/variable"
+)
+
+// ExactlyOneOf places a constraint on a group of variable sets such that
+// exactly one of them must be defined.
+//
+// A variable set is considered to be defined if any of its variables has a
+// value, including a default value. Variables that are not relevant, as per
+// RelevantIf(), are treated as undefined.
+//
+// It panics if fewer than two variable sets are given.
+func ExactlyOneOf(sets ...VariableSet) {
+	group("ExactlyOneOf", variable.ExactlyOne, sets)
+}
+
+// AtMostOneOf places a constraint on a group of variable sets such that no
+// more than one of them may be defined.
+//
+// See ExactlyOneOf() for the meaning of "defined". It panics if fewer than two
+// variable sets are given.
+func AtMostOneOf(sets ...VariableSet) {
+	group("AtMostOneOf", variable.AtMostOne, sets)
+}
+
+// AtLeastOneOf places a constraint on a group of variable sets such that at
+// least one of them must be defined.
+//
+// See ExactlyOneOf() for the meaning of "defined". It panics if fewer than two
+// variable sets are given.
+func AtLeastOneOf(sets ...VariableSet) {
+	group("AtLeastOneOf", variable.AtLeastOne, sets)
+}
+
+// AllOrNone places a constraint on a group of variable sets such that either
+// all of them must be defined, or none of them may be defined.
+//
+// See ExactlyOneOf() for the meaning of "defined". It panics if fewer than two
+// variable sets are given.
+func AllOrNone(sets ...VariableSet) {
+	group("AllOrNone", variable.AllOrNone, sets)
+}
+
+// group establishes a group of variable sets that is subject to the constraint
+// c. fn is the name of the function that declared the group, used in panic
+// messages.
+func group(fn string, c variable.GroupConstraint, sets []VariableSet) {
+	if len(sets) < 2 {
+		panic(fmt.Sprintf(
+			"%s() requires at least two variable sets",
+			fn,
+		))
+	}
+
+	members := make([][]variable.Any, len(sets))
+	for i, s := range sets {
+		members[i] = s.variables()
+	}
+
+	variable.NewGroup(c, members...)
+}
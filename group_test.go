@@ -0,0 +1,217 @@
+package ferrite_test
+
+import (
+	"os"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("group constraints", func() {
+	var a, b, c Optional[string]
+
+	BeforeEach(func() {
+		a = String("FERRITE_A", "<desc>").Optional()
+		b = String("FERRITE_B", "<desc>").Optional()
+		c = String("FERRITE_C", "<desc>").Optional()
+	})
+
+	AfterEach(func() {
+		tearDown()
+	})
+
+	Describe("func ExactlyOneOf()", func() {
+		It("does not fail if exactly one of the variables is defined", func() {
+			os.Setenv("FERRITE_B", "<value>")
+			ExactlyOneOf(a, b, c)
+
+			_, err := Validate()
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("fails if more than one of the variables is defined", func() {
+			os.Setenv("FERRITE_A", "<value>")
+			os.Setenv("FERRITE_C", "<value>")
+			ExactlyOneOf(a, b, c)
+
+			_, err := Validate()
+			Expect(err).To(MatchError("FERRITE_A and FERRITE_C are defined, define exactly one of FERRITE_A, FERRITE_B or FERRITE_C"))
+		})
+
+		It("fails if none of the variables are defined", func() {
+			ExactlyOneOf(a, b)
+
+			_, err := Validate()
+			Expect(err).To(MatchError("FERRITE_A and FERRITE_B are undefined, define exactly one of them"))
+		})
+
+		It("treats variables with a default value as defined", func() {
+			os.Setenv("FERRITE_A", "<value>")
+
+			d := String("FERRITE_D", "<desc>").
+				WithDefault("<default>").
+				Optional()
+
+			ExactlyOneOf(a, d)
+
+			_, err := Validate()
+			Expect(err).To(MatchError("FERRITE_A and FERRITE_D are defined, define exactly one of FERRITE_A or FERRITE_D"))
+		})
+
+		It("treats variables that are not relevant as undefined", func() {
+			os.Setenv("FERRITE_A", "<value>")
+			os.Setenv("FERRITE_B", "<value>")
+
+			enabled := Bool("FERRITE_ENABLED", "<desc>").
+				WithDefault(false).
+				Required()
+
+			d := String("FERRITE_D", "<desc>").
+				Optional(RelevantIf(enabled))
+
+			os.Setenv("FERRITE_D", "<value>")
+			ExactlyOneOf(a, d)
+
+			_, err := Validate()
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("panics if fewer than two variable sets are given", func() {
+			Expect(func() {
+				ExactlyOneOf(a)
+			}).To(PanicWith("ExactlyOneOf() requires at least two variable sets"))
+		})
+	})
+
+	Describe("func AtMostOneOf()", func() {
+		It("does not fail if none of the variables are defined", func() {
+			AtMostOneOf(a, b)
+
+			_, err := Validate()
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("fails if more than one of the variables is defined", func() {
+			os.Setenv("FERRITE_A", "<value>")
+			os.Setenv("FERRITE_B", "<value>")
+			AtMostOneOf(a, b)
+
+			_, err := Validate()
+			Expect(err).To(MatchError("FERRITE_A and FERRITE_B are defined, define no more than one of FERRITE_A or FERRITE_B"))
+		})
+	})
+
+	Describe("func AtLeastOneOf()", func() {
+		It("does not fail if all of the variables are defined", func() {
+			os.Setenv("FERRITE_A", "<value>")
+			os.Setenv("FERRITE_B", "<value>")
+			AtLeastOneOf(a, b)
+
+			_, err := Validate()
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("fails if none of the variables are defined", func() {
+			AtLeastOneOf(a, b, c)
+
+			_, err := Validate()
+			Expect(err).To(MatchError("FERRITE_A, FERRITE_B and FERRITE_C are undefined, define at least one of them"))
+		})
+	})
+
+	Describe("func AllOrNone()", func() {
+		It("does not fail if all of the variables are defined", func() {
+			os.Setenv("FERRITE_A", "<value>")
+			os.Setenv("FERRITE_B", "<value>")
+			AllOrNone(a, b)
+
+			_, err := Validate()
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("does not fail if none of the variables are defined", func() {
+			AllOrNone(a, b)
+
+			_, err := Validate()
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("fails if only some of the variables are defined", func() {
+			os.Setenv("FERRITE_B", "<value>")
+			AllOrNone(a, b, c)
+
+			_, err := Validate()
+			Expect(err).To(MatchError("FERRITE_B is defined but FERRITE_A and FERRITE_C are not, define all or none"))
+		})
+
+		It("uses the names of all variables in a set that contains multiple variables", func() {
+			os.Setenv("FERRITE_A", "<value>")
+
+			svc := KubernetesService("ferrite-svc").
+				Optional()
+
+			AllOrNone(a, svc)
+
+			_, err := Validate()
+			Expect(err).To(MatchError("FERRITE_A is defined but FERRITE_SVC_SERVICE_HOST/FERRITE_SVC_SERVICE_PORT is not, define both or neither"))
+		})
+	})
+})
+
+func ExampleExactlyOneOf() {
+	defer example()()
+
+	url := ferrite.
+		URL("FERRITE_DB_URL", "example database URL").
+		Optional()
+
+	host := ferrite.
+		String("FERRITE_DB_HOST", "example database host").
+		Optional()
+
+	ferrite.ExactlyOneOf(url, host)
+
+	os.Setenv("FERRITE_DB_URL", "postgres://localhost/db")
+	os.Setenv("FERRITE_DB_HOST", "localhost")
+	ferrite.Init()
+
+	// Output:
+	// Environment Variables:
+	//
+	//    FERRITE_DB_HOST  example database host  [ <string> ]  ✓ set to localhost
+	//    FERRITE_DB_URL   example database URL   [ <string> ]  ✓ set to postgres://localhost/db
+	//
+	//  ✗ FERRITE_DB_URL and FERRITE_DB_HOST are defined, define exactly one of FERRITE_DB_URL or FERRITE_DB_HOST
+	//
+	// <process exited with error code 1>
+}
+
+func ExampleAllOrNone() {
+	defer example()()
+
+	user := ferrite.
+		String("FERRITE_DB_USER", "example database user").
+		Optional()
+
+	password := ferrite.
+		String("FERRITE_DB_PASSWORD", "example database password").
+		WithSensitiveContent().
+		Optional()
+
+	ferrite.AllOrNone(user, password)
+
+	os.Setenv("FERRITE_DB_USER", "admin")
+	ferrite.Init()
+
+	// Output:
+	// Environment Variables:
+	//
+	//    FERRITE_DB_PASSWORD  example database password  [ <string> ]  • undefined
+	//    FERRITE_DB_USER      example database user      [ <string> ]  ✓ set to admin
+	//
+	//  ✗ FERRITE_DB_USER is defined but FERRITE_DB_PASSWORD is not, define both or neither
+	//
+	// <process exited with error code 1>
+}
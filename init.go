@@ -5,8 +5,14 @@ import (
 
 	"github.com/dogmatiq/ferrite/internal/environment"
 	"github.com/dogmatiq/ferrite/internal/mode"
+	"github.com/dogmatiq/ferrite/internal/mode/export/compose"
 	"github.com/dogmatiq/ferrite/internal/mode/export/dotenv"
+	"github.com/dogmatiq/ferrite/internal/mode/export/jsonschema"
+	"github.com/dogmatiq/ferrite/internal/mode/export/kubernetes"
+	"github.com/dogmatiq/ferrite/internal/mode/usage/json"
+	"github.com/dogmatiq/ferrite/internal/mode/usage/man"
 	"github.com/dogmatiq/ferrite/internal/mode/usage/markdown"
+	"github.com/dogmatiq/ferrite/internal/mode/usage/text"
 	"github.com/dogmatiq/ferrite/internal/mode/validate"
 	"github.com/dogmatiq/ferrite/internal/variable"
 )
@@ -14,7 +20,8 @@ import (
 // Init initializes Ferrite.
 //
 // Different modes can be selected by setting the `FERRITE_MODE` environment
-// variable.
+// variable. The mode is always obtained from the process's environment, even if
+// the [WithLookup] or [WithDotEnvFiles] options are used.
 //
 // "validate" mode: This is the default mode. If one or more environment
 // variables are invalid, this mode renders a description of all declared
@@ -23,30 +30,73 @@ import (
 //
 // It also shows warnings if deprecated environment variables are used.
 //
+// "validate/json" mode: This mode validates the environment variables in the
+// same way as "validate" mode, but renders a JSON document describing each
+// variable and its value to `STDOUT`, one per line. It exits with a non-zero
+// exit code if one or more environment variables are invalid.
+//
 // "usage/markdown" mode: This mode renders Markdown documentation about the
 // environment variables to `STDOUT`. The output is designed to be included in
 // the application's `README.md` file or a similar file.
 //
+// "usage/json" mode: This mode renders a machine-readable description of the
+// environment variables to `STDOUT` in a versioned JSON format, suitable for
+// consumption by other tools.
+//
+// "usage/man" mode: This mode renders documentation about the environment
+// variables to `STDOUT` as the ENVIRONMENT section of a manual page, in roff
+// format.
+//
+// "usage/text" mode: This mode renders documentation about the environment
+// variables to `STDOUT` as plain text, wrapped to the width of the terminal.
+//
 // "export/dotenv" mode: This mode renders environment variables to `STDOUT` in
 // a format suitable for use as a `.env` file.
+//
+// "export/kubernetes" mode: This mode renders Kubernetes ConfigMap and Secret
+// manifests containing the environment variables to `STDOUT`.
+//
+// "export/compose" mode: This mode renders a Docker Compose file containing the
+// environment variables to `STDOUT`. Variables without a current value are
+// rendered using Compose's interpolation syntax.
+//
+// "export/json-schema" mode: This mode renders a JSON Schema document that
+// describes the environment variables as the properties of an object to
+// `STDOUT`.
 func Init(options ...InitOption) {
-	cfg := initConfig{
-		mode.DefaultConfig,
+	cfg, err := newInitConfig(options)
+	if err != nil {
+		fmt.Fprintf(cfg.ModeConfig.Err, "%s\n", err)
+		cfg.ModeConfig.Exit(1)
+		return
 	}
 
-	cfg.ModeConfig.Registries.Add(variable.DefaultRegistry)
-
-	for _, opt := range options {
-		opt.applyInitOption(&cfg)
-	}
+	// Resolve the variables using this call's lookup. Unlike Validate(), this
+	// affects the values that the variables return from now on, as Init() is
+	// intended to configure the application as a whole.
+	cfg.ModeConfig.Registries.Reset(cfg.Lookup)
 
 	switch m := environment.Get("FERRITE_MODE"); m {
 	case "validate", "":
 		validate.Run(cfg.ModeConfig)
+	case "validate/json":
+		validate.RunJSON(cfg.ModeConfig)
 	case "usage/markdown":
 		markdown.Run(cfg.ModeConfig)
+	case "usage/json":
+		json.Run(cfg.ModeConfig)
+	case "usage/man":
+		man.Run(cfg.ModeConfig)
+	case "usage/text":
+		text.Run(cfg.ModeConfig)
 	case "export/dotenv":
 		dotenv.Run(cfg.ModeConfig)
+	case "export/kubernetes":
+		kubernetes.Run(cfg.ModeConfig)
+	case "export/compose":
+		compose.Run(cfg.ModeConfig)
+	case "export/json-schema":
+		jsonschema.Run(cfg.ModeConfig)
 	default:
 		fmt.Fprintf(cfg.ModeConfig.Err, "unrecognized FERRITE_MODE (%s)\n", m)
 		cfg.ModeConfig.Exit(1)
@@ -58,8 +108,43 @@ type InitOption interface {
 	applyInitOption(*initConfig)
 }
 
+// newInitConfig returns the configuration built from the given options.
+//
+// If an error is returned, only the mode configuration's output streams and
+// exit function are meaningful.
+func newInitConfig(options []InitOption) (initConfig, error) {
+	cfg := initConfig{
+		ModeConfig: mode.DefaultConfig,
+	}
+
+	cfg.ModeConfig.Registries.Add(variable.DefaultRegistry)
+
+	for _, opt := range options {
+		opt.applyInitOption(&cfg)
+	}
+
+	if len(cfg.DotEnvFiles) != 0 {
+		files, err := environment.LoadDotEnv(cfg.DotEnvFiles...)
+		if err != nil {
+			return cfg, fmt.Errorf("unable to load dotenv file: %w", err)
+		}
+
+		if cfg.Lookup == nil {
+			cfg.Lookup = environment.Process{}
+		}
+
+		// Layer the dotenv files underneath the environment, such that values
+		// in the environment take precedence.
+		cfg.Lookup = environment.Chain{cfg.Lookup, files}
+	}
+
+	return cfg, nil
+}
+
 // initConfig is the configuration for the Init() function, built from
 // InitOption values.
 type initConfig struct {
-	ModeConfig mode.Config
+	ModeConfig  mode.Config
+	Lookup      environment.Lookup
+	DotEnvFiles []string
 }
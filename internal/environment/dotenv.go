@@ -0,0 +1,347 @@
+package environment
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"strings"
+)
+
+// OriginLookup is a Lookup that can describe where each value is defined.
+type OriginLookup interface {
+	Lookup
+
+	// Origin returns a human-readable description of where the value of the
+	// environment variable with the given name is defined, such as a file name
+	// and line number.
+	Origin(n string) (string, bool)
+}
+
+// Origin returns a human-readable description of where the value of the
+// environment variable with the given name is defined, according to the first
+// lookup in the chain that defines the variable.
+func (c Chain) Origin(n string) (string, bool) {
+	for _, l := range c {
+		if _, ok := l.Lookup(n); ok {
+			if o, ok := l.(OriginLookup); ok {
+				return o.Origin(n)
+			}
+			return "", false
+		}
+	}
+
+	return "", false
+}
+
+// DotEnv is a Lookup that obtains values from the content of one or more
+// dotenv files.
+type DotEnv struct {
+	entries map[string]DotEnvEntry
+}
+
+// DotEnvEntry is a variable definition within a dotenv file.
+type DotEnvEntry struct {
+	Name, Value string
+	File        string
+	Line        int
+}
+
+// LoadDotEnv loads the variables defined in the given dotenv files.
+//
+// If the same variable is defined in more than one file, the definition in the
+// earliest file takes precedence. Files that do not exist are ignored.
+func LoadDotEnv(files ...string) (DotEnv, error) {
+	d := DotEnv{
+		entries: map[string]DotEnvEntry{},
+	}
+
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		} else if err != nil {
+			return DotEnv{}, err
+		}
+
+		entries, err := ParseDotEnv(file, string(data))
+		if err != nil {
+			return DotEnv{}, err
+		}
+
+		loaded := map[string]struct{}{}
+
+		for _, e := range entries {
+			n := NormalizeName(e.Name)
+
+			// Later definitions within the same file replace earlier ones, but
+			// definitions in earlier files take precedence over later files.
+			if _, ok := d.entries[n]; ok {
+				if _, ok := loaded[n]; !ok {
+					continue
+				}
+			}
+
+			loaded[n] = struct{}{}
+			d.entries[n] = e
+		}
+	}
+
+	return d, nil
+}
+
+// Lookup returns the value of the environment variable with the given name.
+func (d DotEnv) Lookup(n string) (string, bool) {
+	e, ok := d.entries[NormalizeName(n)]
+	return e.Value, ok
+}
+
+// Origin returns the file name and line number at which the environment
+// variable with the given name is defined.
+func (d DotEnv) Origin(n string) (string, bool) {
+	if e, ok := d.entries[NormalizeName(n)]; ok {
+		return fmt.Sprintf("%s:%d", e.File, e.Line), true
+	}
+	return "", false
+}
+
+// ParseDotEnv parses the content of a dotenv file.
+//
+// The syntax is a subset of the POSIX shell syntax for variable assignments,
+// as produced by the "export/dotenv" mode. Each line contains an assignment in
+// the form NAME=value, optionally preceded by the "export" keyword. Values may
+// be quoted using single or double quotes, in which case they may span
+// multiple lines. Blank lines and comments beginning with "#" are ignored.
+//
+// file is the name of the file, used only for error messages.
+func ParseDotEnv(file, content string) ([]DotEnvEntry, error) {
+	p := &dotEnvParser{
+		file:    file,
+		content: content,
+		line:    1,
+	}
+	return p.parse()
+}
+
+// dotEnvParser is a parser for the content of dotenv files.
+type dotEnvParser struct {
+	file    string
+	content string
+	offset  int
+	line    int
+	entries []DotEnvEntry
+}
+
+func (p *dotEnvParser) parse() ([]DotEnvEntry, error) {
+	for {
+		p.skipSpace()
+
+		if p.eof() {
+			return p.entries, nil
+		}
+
+		switch p.peek() {
+		case '\n':
+			p.next()
+			continue
+		case '#':
+			p.skipComment()
+			continue
+		}
+
+		line := p.line
+		if err := p.parseAssignment(); err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", p.file, line, err)
+		}
+	}
+}
+
+func (p *dotEnvParser) parseAssignment() error {
+	line := p.line
+	name := p.parseName()
+
+	if name == "export" && p.skipSpace() {
+		name = p.parseName()
+	}
+
+	if name == "" {
+		return errors.New("expected a variable name")
+	}
+
+	if p.eof() || p.peek() != '=' {
+		return fmt.Errorf("expected '=' after %s", name)
+	}
+	p.next()
+
+	value, err := p.parseValue()
+	if err != nil {
+		return err
+	}
+
+	p.skipSpace()
+
+	if !p.eof() {
+		switch p.peek() {
+		case '\n':
+			p.next()
+		case '#':
+			p.skipComment()
+		default:
+			return fmt.Errorf("unexpected character after the value of %s", name)
+		}
+	}
+
+	p.entries = append(p.entries, DotEnvEntry{
+		Name:  name,
+		Value: value,
+		File:  p.file,
+		Line:  line,
+	})
+
+	return nil
+}
+
+func (p *dotEnvParser) parseName() string {
+	start := p.offset
+
+	for !p.eof() {
+		c := p.peek()
+		if c == '_' ||
+			(c >= 'a' && c <= 'z') ||
+			(c >= 'A' && c <= 'Z') ||
+			(c >= '0' && c <= '9' && p.offset > start) {
+			p.next()
+		} else {
+			break
+		}
+	}
+
+	return p.content[start:p.offset]
+}
+
+func (p *dotEnvParser) parseValue() (string, error) {
+	var value strings.Builder
+
+	for !p.eof() {
+		switch c := p.peek(); c {
+		case ' ', '\t', '\r', '\n':
+			return value.String(), nil
+
+		case '\'':
+			p.next()
+			if err := p.parseSingleQuoted(&value); err != nil {
+				return "", err
+			}
+
+		case '"':
+			p.next()
+			if err := p.parseDoubleQuoted(&value); err != nil {
+				return "", err
+			}
+
+		case '\\':
+			p.next()
+			if p.eof() {
+				return "", errors.New("unexpected end of file after '\\'")
+			}
+			if c := p.next(); c != '\n' {
+				value.WriteByte(c)
+			}
+
+		default:
+			value.WriteByte(p.next())
+		}
+	}
+
+	return value.String(), nil
+}
+
+func (p *dotEnvParser) parseSingleQuoted(value *strings.Builder) error {
+	for !p.eof() {
+		c := p.next()
+		if c == '\'' {
+			return nil
+		}
+		value.WriteByte(c)
+	}
+
+	return errors.New("unterminated single-quoted value")
+}
+
+func (p *dotEnvParser) parseDoubleQuoted(value *strings.Builder) error {
+	for !p.eof() {
+		c := p.next()
+
+		switch c {
+		case '"':
+			return nil
+
+		case '\\':
+			if p.eof() {
+				break
+			}
+
+			switch e := p.next(); e {
+			case '"', '\\', '$', '`':
+				value.WriteByte(e)
+			case 'n':
+				value.WriteByte('\n')
+			case '\n':
+				// A backslash-newline sequence is a line continuation.
+			default:
+				value.WriteByte('\\')
+				value.WriteByte(e)
+			}
+
+		default:
+			value.WriteByte(c)
+		}
+	}
+
+	return errors.New("unterminated double-quoted value")
+}
+
+// skipSpace skips over any spaces and tabs. It returns true if any were
+// skipped.
+func (p *dotEnvParser) skipSpace() bool {
+	start := p.offset
+
+	for !p.eof() {
+		switch p.peek() {
+		case ' ', '\t', '\r':
+			p.next()
+		default:
+			return p.offset > start
+		}
+	}
+
+	return p.offset > start
+}
+
+// skipComment skips to the beginning of the next line.
+func (p *dotEnvParser) skipComment() {
+	for !p.eof() {
+		if p.next() == '\n' {
+			return
+		}
+	}
+}
+
+func (p *dotEnvParser) eof() bool {
+	return p.offset >= len(p.content)
+}
+
+func (p *dotEnvParser) peek() byte {
+	return p.content[p.offset]
+}
+
+func (p *dotEnvParser) next() byte {
+	c := p.content[p.offset]
+	p.offset++
+
+	if c == '\n' {
+		p.line++
+	}
+
+	return c
+}
@@ -0,0 +1,202 @@
+package environment_test
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/dogmatiq/ferrite/internal/environment"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("func ParseDotEnv()", func() {
+	DescribeTable(
+		"it parses variable assignments",
+		func(content string, expect map[string]string) {
+			entries, err := ParseDotEnv("<file>", content)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			actual := map[string]string{}
+			for _, e := range entries {
+				actual[e.Name] = e.Value
+			}
+
+			Expect(actual).To(Equal(expect))
+		},
+		Entry(
+			"empty content",
+			"",
+			map[string]string{},
+		),
+		Entry(
+			"unquoted value",
+			"FOO=bar\n",
+			map[string]string{"FOO": "bar"},
+		),
+		Entry(
+			"empty value",
+			"FOO=\n",
+			map[string]string{"FOO": ""},
+		),
+		Entry(
+			"export prefix",
+			"export FOO=bar",
+			map[string]string{"FOO": "bar"},
+		),
+		Entry(
+			"variable named export",
+			"export=bar",
+			map[string]string{"export": "bar"},
+		),
+		Entry(
+			"comments and blank lines",
+			"# comment\n\n  # indented comment\nFOO=bar # trailing comment\nBAR= # comment after empty value\n",
+			map[string]string{"FOO": "bar", "BAR": ""},
+		),
+		Entry(
+			"hash within an unquoted value",
+			"FOO=bar#baz",
+			map[string]string{"FOO": "bar#baz"},
+		),
+		Entry(
+			"single-quoted value",
+			"FOO='hello, world!'",
+			map[string]string{"FOO": "hello, world!"},
+		),
+		Entry(
+			"single-quoted value containing an escaped single quote",
+			`FOO='it'"'"'s'`,
+			map[string]string{"FOO": "it's"},
+		),
+		Entry(
+			"double-quoted value with escape sequences",
+			`FOO="say \"hello\"\nthen \\ \$leave"`,
+			map[string]string{"FOO": "say \"hello\"\nthen \\ $leave"},
+		),
+		Entry(
+			"multiline single-quoted value",
+			"FOO='line 1\nline 2'\nBAR=baz",
+			map[string]string{"FOO": "line 1\nline 2", "BAR": "baz"},
+		),
+		Entry(
+			"multiline double-quoted value",
+			"FOO=\"line 1\nline 2\"",
+			map[string]string{"FOO": "line 1\nline 2"},
+		),
+		Entry(
+			"escaped space in unquoted value",
+			`FOO=hello\ world`,
+			map[string]string{"FOO": "hello world"},
+		),
+		Entry(
+			"windows line endings",
+			"FOO=bar\r\nBAR=baz\r\n",
+			map[string]string{"FOO": "bar", "BAR": "baz"},
+		),
+	)
+
+	It("records the line number of each assignment", func() {
+		entries, err := ParseDotEnv("<file>", "# comment\nFOO='line 1\nline 2'\nBAR=baz\n")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(entries).To(Equal([]DotEnvEntry{
+			{Name: "FOO", Value: "line 1\nline 2", File: "<file>", Line: 2},
+			{Name: "BAR", Value: "baz", File: "<file>", Line: 4},
+		}))
+	})
+
+	DescribeTable(
+		"it returns an error if the content is malformed",
+		func(content, expect string) {
+			_, err := ParseDotEnv("<file>", content)
+			Expect(err).To(MatchError(expect))
+		},
+		Entry(
+			"missing variable name",
+			"=bar",
+			"<file>:1: expected a variable name",
+		),
+		Entry(
+			"missing equals sign",
+			"\nFOO bar",
+			"<file>:2: expected '=' after FOO",
+		),
+		Entry(
+			"unterminated single-quoted value",
+			"FOO='bar\n",
+			"<file>:1: unterminated single-quoted value",
+		),
+		Entry(
+			"unterminated double-quoted value",
+			`FOO="bar`,
+			"<file>:1: unterminated double-quoted value",
+		),
+		Entry(
+			"multiple words",
+			"FOO=bar baz",
+			"<file>:1: unexpected character after the value of FOO",
+		),
+	)
+})
+
+var _ = Describe("func LoadDotEnv()", func() {
+	var dir string
+
+	BeforeEach(func() {
+		dir = GinkgoT().TempDir()
+	})
+
+	writeFile := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		err := os.WriteFile(path, []byte(content), 0600)
+		Expect(err).ShouldNot(HaveOccurred())
+		return path
+	}
+
+	It("gives precedence to definitions in earlier files", func() {
+		local := writeFile(".env.local", "FOO=local\n")
+		shared := writeFile(".env", "FOO=shared\nFOO=shared-override\nBAR=shared\n")
+
+		d, err := LoadDotEnv(local, shared)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		v, ok := d.Lookup("FOO")
+		Expect(ok).To(BeTrue())
+		Expect(v).To(Equal("local"))
+
+		v, ok = d.Lookup("BAR")
+		Expect(ok).To(BeTrue())
+		Expect(v).To(Equal("shared"))
+
+		o, ok := d.Origin("BAR")
+		Expect(ok).To(BeTrue())
+		Expect(o).To(Equal(shared + ":3"))
+	})
+
+	It("uses the last definition within a single file", func() {
+		path := writeFile(".env", "FOO=first\nFOO=second\n")
+
+		d, err := LoadDotEnv(path)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		v, _ := d.Lookup("FOO")
+		Expect(v).To(Equal("second"))
+
+		o, _ := d.Origin("FOO")
+		Expect(o).To(Equal(path + ":2"))
+	})
+
+	It("ignores files that do not exist", func() {
+		d, err := LoadDotEnv(filepath.Join(dir, "missing"))
+		Expect(err).ShouldNot(HaveOccurred())
+
+		_, ok := d.Lookup("FOO")
+		Expect(ok).To(BeFalse())
+	})
+
+	It("returns an error if a file is malformed", func() {
+		path := writeFile(".env", "FOO\n")
+
+		_, err := LoadDotEnv(path)
+		Expect(err).To(MatchError(path + ":1: expected '=' after FOO"))
+	})
+})
@@ -0,0 +1,53 @@
+package environment
+
+import "os"
+
+// Lookup is a source of environment variable values.
+type Lookup interface {
+	// Lookup returns the value of the environment variable with the given
+	// name. ok is false if the variable is not defined.
+	Lookup(n string) (v string, ok bool)
+}
+
+// Process is a Lookup that obtains values from the process's environment.
+type Process struct{}
+
+// Lookup returns the value of the environment variable with the given name.
+func (Process) Lookup(n string) (string, bool) {
+	return os.LookupEnv(n)
+}
+
+// Map is a Lookup that obtains values from a map of variable name to value.
+type Map map[string]string
+
+// Lookup returns the value of the environment variable with the given name.
+func (m Map) Lookup(n string) (string, bool) {
+	if v, ok := m[n]; ok {
+		return v, true
+	}
+
+	for k, v := range m {
+		if EqualNames(k, n) {
+			return v, true
+		}
+	}
+
+	return "", false
+}
+
+// Chain is a Lookup that obtains values from a sequence of other lookups.
+//
+// The value is obtained from the first lookup in the sequence that defines the
+// variable.
+type Chain []Lookup
+
+// Lookup returns the value of the environment variable with the given name.
+func (c Chain) Lookup(n string) (string, bool) {
+	for _, l := range c {
+		if v, ok := l.Lookup(n); ok {
+			return v, true
+		}
+	}
+
+	return "", false
+}
@@ -0,0 +1,3 @@
+// Package compose is a Ferrite mode that exports the environment variables as
+// a Docker Compose service definition.
+package compose
@@ -0,0 +1,128 @@
+package compose
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/dogmatiq/ferrite/internal/mode"
+	"github.com/dogmatiq/ferrite/internal/mode/export/internal/manifest"
+	"github.com/dogmatiq/ferrite/internal/variable"
+	"gopkg.in/yaml.v3"
+)
+
+// Run generates a Docker Compose file describing the environment variables and
+// their current values.
+//
+// Valid, non-sensitive values are rendered verbatim. Otherwise, variables are
+// rendered using Compose's interpolation syntax such that the Compose file
+// documents the behavior when the variable is not defined.
+func Run(cfg mode.Config) {
+	app := serviceName(filepath.Base(cfg.Args[0]))
+	env := manifest.Mapping()
+
+	for _, v := range cfg.Registries.Variables() {
+		s := v.Spec()
+
+		hasValue := v.Source() == variable.SourceEnvironment || v.Source() == variable.SourceFile
+		if s.IsDeprecated() && !hasValue {
+			continue
+		}
+
+		key := manifest.Scalar(s.Name())
+		key.HeadComment = manifest.Comment(s)
+
+		var value string
+		if hasValue {
+			if err := v.Error(); err != nil {
+				key.LineComment = manifest.ErrorComment(s, err)
+			} else if !s.IsSensitive() {
+				value = escape(v.Value().Verbatim().String)
+			}
+		}
+
+		if value == "" {
+			value = interpolate(s)
+		}
+
+		env.Content = append(env.Content, key, manifest.Scalar(value))
+	}
+
+	doc := manifest.Mapping(
+		manifest.Scalar("services"), manifest.Mapping(
+			manifest.Scalar(app), manifest.Mapping(
+				manifest.Scalar("environment"), env,
+			),
+		),
+	)
+
+	enc := yaml.NewEncoder(cfg.Out)
+	enc.SetIndent(2)
+
+	if err := enc.Encode(doc); err != nil {
+		panic(err)
+	}
+
+	if err := enc.Close(); err != nil {
+		panic(err)
+	}
+
+	cfg.Exit(0)
+}
+
+// interpolate returns a Compose interpolation expression that obtains the
+// value of s from the environment in which Compose is run.
+func interpolate(s variable.Spec) string {
+	if def, ok := s.Default(); ok {
+		if s.IsSensitive() {
+			// Avoid revealing the default value, an empty value causes the
+			// application to fall back to the default anyway.
+			return fmt.Sprintf("${%s:-}", s.Name())
+		}
+
+		if strings.Contains(def.String, "}") {
+			// Compose has no way to escape a closing brace within the default
+			// value of an interpolation expression, so we rely on the
+			// application falling back to the default instead.
+			return fmt.Sprintf("${%s:-}", s.Name())
+		}
+
+		return fmt.Sprintf("${%s:-%s}", s.Name(), escape(def.String))
+	}
+
+	if s.IsRequired() && !s.IsDeprecated() {
+		return fmt.Sprintf("${%s:?required}", s.Name())
+	}
+
+	return fmt.Sprintf("${%s:-}", s.Name())
+}
+
+// escape escapes any dollar signs in v so that Compose does not treat them as
+// the beginning of an interpolation expression.
+func escape(v string) string {
+	return strings.ReplaceAll(v, "$", "$$")
+}
+
+// serviceName returns a valid Compose service name based on the given
+// application name.
+func serviceName(app string) string {
+	var w strings.Builder
+
+	for _, r := range app {
+		if (r >= 'a' && r <= 'z') ||
+			(r >= 'A' && r <= 'Z') ||
+			(r >= '0' && r <= '9') ||
+			r == '.' || r == '_' || r == '-' {
+			w.WriteRune(r)
+		} else if w.Len() != 0 {
+			w.WriteByte('-')
+		}
+	}
+
+	name := strings.Trim(w.String(), "-")
+	if name == "" {
+		return "app"
+	}
+
+	return name
+}
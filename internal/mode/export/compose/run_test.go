@@ -0,0 +1,40 @@
+package compose_test
+
+import (
+	"bytes"
+
+	"github.com/dogmatiq/ferrite/internal/mode"
+	. "github.com/dogmatiq/ferrite/internal/mode/export/compose"
+	"github.com/dogmatiq/ferrite/internal/variable"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("func Run()", func() {
+	DescribeTable(
+		"it uses a valid service name based on the application name",
+		func(app, expect string) {
+			out := &bytes.Buffer{}
+
+			cfg := mode.Config{
+				Args: []string{app},
+				Out:  out,
+				Exit: func(code int) {
+					Expect(code).To(Equal(0))
+				},
+			}
+			cfg.Registries.Add(&variable.Registry{IsDefault: true})
+
+			Run(cfg)
+
+			Expect(out.String()).To(Equal(
+				"services:\n" +
+					"  " + expect + ":\n" +
+					"    environment: {}\n",
+			))
+		},
+		Entry("valid name", "/path/to/my-app_1.2", "my-app_1.2"),
+		Entry("invalid characters", "/path/to/my app (v2)", "my-app--v2"),
+		Entry("no valid characters", "<>", "app"),
+	)
+})
@@ -66,6 +66,26 @@ func Run(cfg mode.Config) {
 		}
 
 		must.Fprintf(cfg.Out, "\n")
+
+		if v.Source() == variable.SourceFile {
+			name, _ := v.FileVariableName()
+
+			must.Fprintf(
+				cfg.Out,
+				"export %s=%s",
+				name,
+				variable.Literal{String: v.File()}.Quote(),
+			)
+
+			switch err := v.Error().(type) {
+			case variable.FileError:
+				must.Fprintf(cfg.Out, " # unable to read file: %s", err.Unwrap())
+			case variable.ValueError:
+				must.Fprintf(cfg.Out, " # file content is invalid: %s", err.Unwrap())
+			}
+
+			must.Fprintf(cfg.Out, "\n")
+		}
 	}
 
 	cfg.Exit(0)
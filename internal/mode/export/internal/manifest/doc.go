@@ -0,0 +1,3 @@
+// Package manifest contains utilities for exporting variables to YAML-based
+// deployment manifests, such as Docker Compose files and Kubernetes resources.
+package manifest
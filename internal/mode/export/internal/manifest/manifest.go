@@ -0,0 +1,74 @@
+package manifest
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dogmatiq/ferrite/internal/mode/internal/render"
+	"github.com/dogmatiq/ferrite/internal/variable"
+	"gopkg.in/yaml.v3"
+)
+
+// Comment returns a comment describing the variable s.
+func Comment(s variable.Spec) string {
+	var w strings.Builder
+
+	w.WriteString(s.Description())
+	w.WriteString(" (")
+
+	if def, ok := s.Default(); ok {
+		w.WriteString("default: ")
+		w.WriteString(render.Value(s, def))
+	} else if s.IsDeprecated() {
+		w.WriteString("deprecated")
+	} else if s.IsRequired() {
+		w.WriteString("required")
+	} else {
+		w.WriteString("optional")
+	}
+
+	if s.IsSensitive() {
+		w.WriteString(", sensitive")
+	}
+
+	w.WriteString(")")
+
+	return w.String()
+}
+
+// ErrorComment returns a comment describing err, the error produced by the
+// variable s.
+//
+// The invalid value is omitted if s is sensitive.
+func ErrorComment(s variable.Spec, err variable.Error) string {
+	if err, ok := err.(variable.ValueError); ok {
+		if s.IsSensitive() {
+			return fmt.Sprintf("value is invalid: %s", err.Unwrap())
+		}
+
+		return fmt.Sprintf(
+			"%s is invalid: %s",
+			err.Literal().Quote(),
+			err.Unwrap(),
+		)
+	}
+
+	return err.Error()
+}
+
+// Mapping returns a YAML mapping node containing the given keys and values.
+func Mapping(content ...*yaml.Node) *yaml.Node {
+	return &yaml.Node{
+		Kind:    yaml.MappingNode,
+		Content: content,
+	}
+}
+
+// Scalar returns a YAML string node containing v.
+func Scalar(v string) *yaml.Node {
+	return &yaml.Node{
+		Kind:  yaml.ScalarNode,
+		Tag:   "!!str",
+		Value: v,
+	}
+}
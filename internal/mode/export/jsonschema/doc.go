@@ -0,0 +1,3 @@
+// Package jsonschema is a Ferrite mode that exports the specification of the
+// environment variables as a JSON Schema document.
+package jsonschema
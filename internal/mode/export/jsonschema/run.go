@@ -0,0 +1,190 @@
+package jsonschema
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/dogmatiq/ferrite/internal/mode"
+	"github.com/dogmatiq/ferrite/internal/variable"
+)
+
+// Run generates a JSON Schema document that describes the environment
+// variables as the properties of an object.
+func Run(cfg mode.Config) {
+	doc := document{
+		Schema:     "https://json-schema.org/draft/2020-12/schema",
+		Title:      filepath.Base(cfg.Args[0]),
+		Type:       "object",
+		Properties: map[string]*property{},
+		Required:   []string{},
+	}
+
+	for _, v := range cfg.Registries.Variables() {
+		s := v.Spec()
+
+		if _, ok := doc.Properties[s.Name()]; ok {
+			continue
+		}
+
+		doc.Properties[s.Name()] = propertyOf(s)
+
+		if _, ok := s.Default(); !ok && s.IsRequired() && !s.IsDeprecated() {
+			doc.Required = append(doc.Required, s.Name())
+		}
+	}
+
+	enc := json.NewEncoder(cfg.Out)
+	enc.SetIndent("", "  ")
+
+	if err := enc.Encode(doc); err != nil {
+		panic(err)
+	}
+
+	cfg.Exit(0)
+}
+
+// document is a JSON Schema document describing a set of environment
+// variables.
+type document struct {
+	Schema     string               `json:"$schema"`
+	Title      string               `json:"title"`
+	Type       string               `json:"type"`
+	Properties map[string]*property `json:"properties"`
+	Required   []string             `json:"required"`
+}
+
+// property is the JSON Schema for a single environment variable.
+type property struct {
+	Description  string          `json:"description"`
+	Type         string          `json:"type"`
+	Enum         []string        `json:"enum,omitempty"`
+	Minimum      json.RawMessage `json:"minimum,omitempty"`
+	Maximum      json.RawMessage `json:"maximum,omitempty"`
+	MinLength    *int            `json:"minLength,omitempty"`
+	MaxLength    *int            `json:"maxLength,omitempty"`
+	Pattern      string          `json:"pattern,omitempty"`
+	Default      json.RawMessage `json:"default,omitempty"`
+	IsDeprecated bool            `json:"deprecated,omitempty"`
+	IsWriteOnly  bool            `json:"writeOnly,omitempty"`
+}
+
+// propertyOf returns the JSON Schema property that describes s.
+func propertyOf(s variable.Spec) *property {
+	p := &property{
+		Description:  s.Description(),
+		Type:         "string",
+		IsDeprecated: s.IsDeprecated(),
+		IsWriteOnly:  s.IsSensitive(),
+	}
+
+	s.Schema().AcceptVisitor(&propertyBuilder{p})
+
+	// The default value of a sensitive variable is omitted so that it is not
+	// revealed by the schema.
+	if def, ok := s.Default(); ok && !s.IsSensitive() {
+		if p.Type == "string" {
+			p.Default = str(def.String)
+		} else if n, ok := number(def); ok {
+			p.Default = n
+		}
+	}
+
+	return p
+}
+
+// propertyBuilder populates a property based on a variable's schema.
+type propertyBuilder struct {
+	Property *property
+}
+
+func (b *propertyBuilder) VisitBinary(variable.Binary) {
+}
+
+func (b *propertyBuilder) VisitMap(variable.Map) {
+}
+
+func (b *propertyBuilder) VisitNumeric(s variable.Numeric) {
+	min, max, _ := s.Limits()
+
+	// Only numeric types with a plain numeric representation are described
+	// as JSON numbers. Others, such as durations, remain strings.
+	minNum, minOK := number(min)
+	maxNum, maxOK := number(max)
+	if !minOK || !maxOK {
+		return
+	}
+
+	if isInteger(min) && isInteger(max) {
+		b.Property.Type = "integer"
+	} else {
+		b.Property.Type = "number"
+	}
+
+	b.Property.Minimum = minNum
+	b.Property.Maximum = maxNum
+}
+
+func (b *propertyBuilder) VisitSet(s variable.Set) {
+	for _, lit := range s.Literals() {
+		b.Property.Enum = append(b.Property.Enum, lit.String)
+	}
+}
+
+func (b *propertyBuilder) VisitSlice(variable.Slice) {
+}
+
+func (b *propertyBuilder) VisitString(s variable.String) {
+	if n, ok := s.MinLength(); ok {
+		b.Property.MinLength = &n
+	}
+
+	if n, ok := s.MaxLength(); ok {
+		b.Property.MaxLength = &n
+	}
+
+	if re, ok := s.Pattern(); ok {
+		b.Property.Pattern = re.String()
+	}
+}
+
+func (b *propertyBuilder) VisitOther(variable.Other) {
+}
+
+// number returns the JSON representation of a numeric literal.
+//
+// ok is false if the literal can not be represented as a JSON number.
+func number(lit variable.Literal) (_ json.RawMessage, ok bool) {
+	v := strings.TrimPrefix(lit.String, "+")
+
+	if _, err := strconv.ParseFloat(v, 64); err != nil {
+		return nil, false
+	}
+
+	if !json.Valid([]byte(v)) {
+		return nil, false
+	}
+
+	return json.RawMessage(v), true
+}
+
+// isInteger returns true if lit is an integer literal.
+func isInteger(lit variable.Literal) bool {
+	_, err := strconv.ParseInt(lit.String, 10, 64)
+	if err == nil {
+		return true
+	}
+
+	_, err = strconv.ParseUint(strings.TrimPrefix(lit.String, "+"), 10, 64)
+	return err == nil
+}
+
+// str returns the JSON representation of a string.
+func str(v string) json.RawMessage {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return data
+}
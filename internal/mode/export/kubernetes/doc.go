@@ -0,0 +1,3 @@
+// Package kubernetes is a Ferrite mode that exports the environment variables
+// as Kubernetes ConfigMap and Secret manifests.
+package kubernetes
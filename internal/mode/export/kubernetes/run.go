@@ -0,0 +1,140 @@
+package kubernetes
+
+import (
+	"encoding/base64"
+	"path/filepath"
+	"strings"
+
+	"github.com/dogmatiq/ferrite/internal/mode"
+	"github.com/dogmatiq/ferrite/internal/mode/export/internal/manifest"
+	"github.com/dogmatiq/ferrite/internal/variable"
+	"github.com/dogmatiq/iago/must"
+	"gopkg.in/yaml.v3"
+)
+
+// Run generates Kubernetes manifests describing the environment variables and
+// their current values.
+//
+// Non-sensitive variables are placed in a ConfigMap and sensitive variables are
+// placed in a Secret. Variables that are undefined or that use their default
+// value are omitted, as are those that are implicitly defined by Kubernetes,
+// such as those used by KubernetesService().
+func Run(cfg mode.Config) {
+	name := resourceName(filepath.Base(cfg.Args[0]))
+	configMap := manifest.Mapping()
+	secret := manifest.Mapping()
+
+	for _, v := range cfg.Registries.Variables() {
+		s := v.Spec()
+
+		if s.IsDefinedByKubernetes() {
+			continue
+		}
+
+		if v.Source() != variable.SourceEnvironment && v.Source() != variable.SourceFile {
+			continue
+		}
+
+		key := manifest.Scalar(s.Name())
+		key.HeadComment = manifest.Comment(s)
+
+		value := ""
+		if err := v.Error(); err != nil {
+			key.LineComment = manifest.ErrorComment(s, err)
+		} else {
+			value = v.Value().Verbatim().String
+		}
+
+		if s.IsSensitive() {
+			secret.Content = append(
+				secret.Content,
+				key,
+				manifest.Scalar(base64.StdEncoding.EncodeToString([]byte(value))),
+			)
+		} else {
+			configMap.Content = append(
+				configMap.Content,
+				key,
+				manifest.Scalar(value),
+			)
+		}
+	}
+
+	enc := yaml.NewEncoder(cfg.Out)
+	enc.SetIndent(2)
+
+	if len(configMap.Content) != 0 {
+		if err := enc.Encode(resource("ConfigMap", name, configMap)); err != nil {
+			panic(err)
+		}
+	}
+
+	if len(secret.Content) != 0 {
+		if err := enc.Encode(resource("Secret", name, secret)); err != nil {
+			panic(err)
+		}
+	}
+
+	if err := enc.Close(); err != nil {
+		panic(err)
+	}
+
+	if len(configMap.Content) != 0 || len(secret.Content) != 0 {
+		must.WriteString(cfg.Out, "\n")
+		must.WriteString(cfg.Out, "# To use these values, add the following to the container specification:\n")
+		must.WriteString(cfg.Out, "#\n")
+		must.WriteString(cfg.Out, "# envFrom:\n")
+
+		if len(configMap.Content) != 0 {
+			must.WriteString(cfg.Out, "#   - configMapRef:\n")
+			must.Fprintf(cfg.Out, "#       name: %s\n", name)
+		}
+
+		if len(secret.Content) != 0 {
+			must.WriteString(cfg.Out, "#   - secretRef:\n")
+			must.Fprintf(cfg.Out, "#       name: %s\n", name)
+		}
+	}
+
+	cfg.Exit(0)
+}
+
+// resource returns a Kubernetes resource of the given kind containing data.
+func resource(kind, name string, data *yaml.Node) *yaml.Node {
+	m := manifest.Mapping(
+		manifest.Scalar("apiVersion"), manifest.Scalar("v1"),
+		manifest.Scalar("kind"), manifest.Scalar(kind),
+		manifest.Scalar("metadata"), manifest.Mapping(
+			manifest.Scalar("name"), manifest.Scalar(name),
+		),
+	)
+
+	if kind == "Secret" {
+		m.Content = append(m.Content, manifest.Scalar("type"), manifest.Scalar("Opaque"))
+	}
+
+	m.Content = append(m.Content, manifest.Scalar("data"), data)
+
+	return m
+}
+
+// resourceName returns a valid Kubernetes resource name based on the given
+// application name.
+func resourceName(app string) string {
+	var w strings.Builder
+
+	for _, r := range strings.ToLower(app) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			w.WriteRune(r)
+		} else if w.Len() != 0 {
+			w.WriteByte('-')
+		}
+	}
+
+	name := strings.Trim(w.String(), "-")
+	if name == "" {
+		return "app"
+	}
+
+	return name
+}
@@ -48,6 +48,10 @@ func (r *valueRenderer) VisitBinary(s variable.Binary) {
 	r.Out.WriteByte('}')
 }
 
+func (r *valueRenderer) VisitMap(s variable.Map) {
+	r.visitGeneric(s)
+}
+
 func (r *valueRenderer) VisitNumeric(s variable.Numeric) {
 	r.visitGeneric(s)
 }
@@ -56,6 +60,10 @@ func (r *valueRenderer) VisitSet(s variable.Set) {
 	r.visitGeneric(s)
 }
 
+func (r *valueRenderer) VisitSlice(s variable.Slice) {
+	r.visitGeneric(s)
+}
+
 func (r *valueRenderer) VisitString(s variable.String) {
 	r.visitGeneric(s)
 }
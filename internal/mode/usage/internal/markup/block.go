@@ -0,0 +1,133 @@
+package markup
+
+import (
+	"strings"
+)
+
+// BlockKind is an enumeration of the kinds of block-level elements.
+type BlockKind int
+
+const (
+	// VariableHeading is the heading that introduces a variable.
+	VariableHeading BlockKind = iota
+
+	// Heading is a heading within the section that describes a variable, such
+	// as "See Also" or the summary of some documentation.
+	Heading
+
+	// Description is the short description of a variable.
+	Description
+
+	// Paragraph is a paragraph of (possibly formatted) text.
+	Paragraph
+
+	// Code is a block of verbatim text, such as a list of examples.
+	Code
+
+	// ListItem is an item within a bulleted list.
+	ListItem
+)
+
+// Block is a block-level element.
+type Block struct {
+	Kind BlockKind
+
+	// Text is the text of the block, which may contain inline formatting. It is
+	// empty for code blocks.
+	Text string
+
+	// Lines contains the lines of a code block.
+	Lines []string
+}
+
+// Parse parses Markdown text into a sequence of blocks.
+func Parse(md string) []Block {
+	var (
+		blocks []Block
+		para   []string
+		code   *Block
+	)
+
+	flush := func() {
+		if len(para) != 0 {
+			blocks = append(blocks, Block{
+				Kind: Paragraph,
+				Text: strings.Join(para, " "),
+			})
+			para = nil
+		}
+	}
+
+	for _, line := range strings.Split(md, "\n") {
+		if code != nil {
+			if line == "```" {
+				blocks = append(blocks, *code)
+				code = nil
+			} else {
+				code.Lines = append(code.Lines, line)
+			}
+			continue
+		}
+
+		switch {
+		case line == "":
+			flush()
+
+		case strings.HasPrefix(line, "```"):
+			flush()
+			code = &Block{Kind: Code}
+
+		case strings.HasPrefix(line, "### "):
+			flush()
+			blocks = append(blocks, Block{
+				Kind: VariableHeading,
+				Text: strings.TrimPrefix(line, "### "),
+			})
+
+		case strings.HasPrefix(line, "#### "):
+			flush()
+			blocks = append(blocks, Block{
+				Kind: Heading,
+				Text: strings.TrimPrefix(line, "#### "),
+			})
+
+		case strings.HasPrefix(line, "> "):
+			flush()
+			blocks = append(blocks, Block{
+				Kind: Description,
+				Text: strings.TrimPrefix(line, "> "),
+			})
+
+		case strings.HasPrefix(line, "- "):
+			flush()
+			blocks = append(blocks, Block{
+				Kind: ListItem,
+				Text: strings.TrimPrefix(line, "- "),
+			})
+
+		case strings.HasPrefix(line, "<summary>"):
+			flush()
+			blocks = append(blocks, Block{
+				Kind: Heading,
+				Text: strings.TrimSuffix(
+					strings.TrimPrefix(line, "<summary>"),
+					"</summary>",
+				),
+			})
+
+		case line == "<details>", line == "</details>":
+			flush()
+
+		default:
+			para = append(para, line)
+		}
+	}
+
+	flush()
+
+	if code != nil {
+		blocks = append(blocks, *code)
+	}
+
+	return blocks
+}
@@ -0,0 +1,99 @@
+package markup_test
+
+import (
+	. "github.com/dogmatiq/ferrite/internal/mode/usage/internal/markup"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("func Parse()", func() {
+	DescribeTable(
+		"it parses Markdown into blocks",
+		func(md string, expect []Block) {
+			Expect(Parse(md)).To(Equal(expect))
+		},
+		Entry(
+			"empty text",
+			"",
+			nil,
+		),
+		Entry(
+			"variable heading",
+			"### `FERRITE_STRING`",
+			[]Block{
+				{Kind: VariableHeading, Text: "`FERRITE_STRING`"},
+			},
+		),
+		Entry(
+			"heading",
+			"#### See also",
+			[]Block{
+				{Kind: Heading, Text: "See also"},
+			},
+		),
+		Entry(
+			"description",
+			"> example string",
+			[]Block{
+				{Kind: Description, Text: "example string"},
+			},
+		),
+		Entry(
+			"paragraphs spanning multiple lines",
+			"The value is **required**.\nIt must not be empty.\n\nSecond paragraph.",
+			[]Block{
+				{Kind: Paragraph, Text: "The value is **required**. It must not be empty."},
+				{Kind: Paragraph, Text: "Second paragraph."},
+			},
+		),
+		Entry(
+			"list items",
+			"Constraints:\n- **MUST** be `a`\n- **MUST** be `b`",
+			[]Block{
+				{Kind: Paragraph, Text: "Constraints:"},
+				{Kind: ListItem, Text: "**MUST** be `a`"},
+				{Kind: ListItem, Text: "**MUST** be `b`"},
+			},
+		),
+		Entry(
+			"code block",
+			"```bash\nexport FERRITE_STRING=foo\n\nexport FERRITE_STRING=bar\n```",
+			[]Block{
+				{
+					Kind: Code,
+					Lines: []string{
+						"export FERRITE_STRING=foo",
+						"",
+						"export FERRITE_STRING=bar",
+					},
+				},
+			},
+		),
+		Entry(
+			"unterminated code block",
+			"```\nexport FERRITE_STRING=foo",
+			[]Block{
+				{
+					Kind:  Code,
+					Lines: []string{"export FERRITE_STRING=foo"},
+				},
+			},
+		),
+		Entry(
+			"collapsible details",
+			"<details>\n<summary>String syntax</summary>\n\nAny string.\n\n</details>",
+			[]Block{
+				{Kind: Heading, Text: "String syntax"},
+				{Kind: Paragraph, Text: "Any string."},
+			},
+		),
+		Entry(
+			"paragraph interrupted by another block",
+			"first line\n> description",
+			[]Block{
+				{Kind: Paragraph, Text: "first line"},
+				{Kind: Description, Text: "description"},
+			},
+		),
+	)
+})
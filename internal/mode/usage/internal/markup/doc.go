@@ -0,0 +1,3 @@
+// Package markup parses the subset of Markdown produced by the "usage/markdown"
+// mode, allowing it to be converted into other formats.
+package markup
@@ -0,0 +1,134 @@
+package markup
+
+import (
+	"strings"
+)
+
+// Style is a bit-field describing the formatting of inline text.
+type Style int
+
+const (
+	// Literal indicates that the text is a code span, such as a variable name
+	// or value.
+	Literal Style = 1 << iota
+
+	// Strong indicates that the text is strongly emphasized.
+	Strong
+
+	// Strikethrough indicates that the text is struck through.
+	Strikethrough
+)
+
+// Span is a run of text that has the same formatting.
+type Span struct {
+	Text  string
+	Style Style
+}
+
+// ParseInline parses text containing inline formatting into a sequence of
+// spans.
+//
+// Links are replaced with their text, as they are not meaningful outside of
+// the Markdown document.
+func ParseInline(text string) []Span {
+	p := &inlineParser{}
+	p.parse(text, 0)
+	p.flush(0)
+	return p.spans
+}
+
+// PlainText returns text with all inline formatting removed.
+func PlainText(text string) string {
+	var w strings.Builder
+	for _, s := range ParseInline(text) {
+		w.WriteString(s.Text)
+	}
+	return w.String()
+}
+
+type inlineParser struct {
+	spans []Span
+	text  strings.Builder
+}
+
+func (p *inlineParser) parse(text string, style Style) Style {
+	for len(text) != 0 {
+		switch {
+		case text[0] == '`':
+			end := strings.IndexByte(text[1:], '`')
+			if end == -1 {
+				break
+			}
+
+			p.flush(style)
+			p.spans = append(p.spans, Span{
+				Text:  text[1 : end+1],
+				Style: style | Literal,
+			})
+			text = text[end+2:]
+			continue
+
+		case strings.HasPrefix(text, "**"):
+			p.flush(style)
+			style ^= Strong
+			text = text[2:]
+			continue
+
+		case strings.HasPrefix(text, "~~"):
+			p.flush(style)
+			style ^= Strikethrough
+			text = text[2:]
+			continue
+
+		case text[0] == '[':
+			end := closingBracket(text)
+			if end == -1 {
+				break
+			}
+
+			style = p.parse(text[1:end], style)
+			text = text[end+1:]
+
+			if strings.HasPrefix(text, "(") {
+				if end := strings.IndexByte(text, ')'); end != -1 {
+					text = text[end+1:]
+				}
+			}
+			continue
+		}
+
+		p.text.WriteByte(text[0])
+		text = text[1:]
+	}
+
+	return style
+}
+
+func (p *inlineParser) flush(style Style) {
+	if p.text.Len() != 0 {
+		p.spans = append(p.spans, Span{
+			Text:  p.text.String(),
+			Style: style,
+		})
+		p.text.Reset()
+	}
+}
+
+// closingBracket returns the index of the bracket that closes the link text
+// that begins at text[0], ignoring any brackets within code spans.
+func closingBracket(text string) int {
+	literal := false
+
+	for i := 1; i < len(text); i++ {
+		switch text[i] {
+		case '`':
+			literal = !literal
+		case ']':
+			if !literal {
+				return i
+			}
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,109 @@
+package markup_test
+
+import (
+	. "github.com/dogmatiq/ferrite/internal/mode/usage/internal/markup"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("func ParseInline()", func() {
+	DescribeTable(
+		"it parses inline formatting into spans",
+		func(text string, expect []Span) {
+			Expect(ParseInline(text)).To(Equal(expect))
+		},
+		Entry(
+			"empty text",
+			"",
+			nil,
+		),
+		Entry(
+			"plain text",
+			"example string",
+			[]Span{
+				{Text: "example string"},
+			},
+		),
+		Entry(
+			"code span",
+			"defaults to `foo`.",
+			[]Span{
+				{Text: "defaults to "},
+				{Text: "foo", Style: Literal},
+				{Text: "."},
+			},
+		),
+		Entry(
+			"strong emphasis",
+			"**MUST** be set",
+			[]Span{
+				{Text: "MUST", Style: Strong},
+				{Text: " be set"},
+			},
+		),
+		Entry(
+			"code span within strong emphasis",
+			"**default is `foo`**",
+			[]Span{
+				{Text: "default is ", Style: Strong},
+				{Text: "foo", Style: Strong | Literal},
+			},
+		),
+		Entry(
+			"strikethrough",
+			"~~`FERRITE_OLD`~~ is deprecated",
+			[]Span{
+				{Text: "FERRITE_OLD", Style: Strikethrough | Literal},
+				{Text: " is deprecated"},
+			},
+		),
+		Entry(
+			"reference link",
+			"see [`FERRITE_OTHER`]",
+			[]Span{
+				{Text: "see "},
+				{Text: "FERRITE_OTHER", Style: Literal},
+			},
+		),
+		Entry(
+			"inline link",
+			"see [the docs](https://example.org) for details",
+			[]Span{
+				{Text: "see the docs for details"},
+			},
+		),
+		Entry(
+			"closing bracket within a code span in a link",
+			"[`a]b`]",
+			[]Span{
+				{Text: "a]b", Style: Literal},
+			},
+		),
+		Entry(
+			"unterminated code span",
+			"a ` b",
+			[]Span{
+				{Text: "a ` b"},
+			},
+		),
+		Entry(
+			"unterminated link",
+			"a [b",
+			[]Span{
+				{Text: "a [b"},
+			},
+		),
+	)
+})
+
+var _ = Describe("func PlainText()", func() {
+	DescribeTable(
+		"it removes inline formatting",
+		func(text, expect string) {
+			Expect(PlainText(text)).To(Equal(expect))
+		},
+		Entry("plain text", "example string", "example string"),
+		Entry("formatted text", "**MUST** be `foo` or ~~`bar`~~", "MUST be foo or bar"),
+		Entry("link", "see [`FERRITE_OTHER`](#FERRITE_OTHER)", "see FERRITE_OTHER"),
+	)
+})
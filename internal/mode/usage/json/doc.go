@@ -0,0 +1,95 @@
+// Package json is a Ferrite mode that renders a machine-readable description of
+// the environment variable specifications in JSON format.
+//
+// The output is a single JSON object. Its "version" property identifies the
+// version of the format, which is incremented whenever a change is made that is
+// not backwards compatible. Adding new properties is considered a backwards
+// compatible change.
+//
+// The current version of the format is 1. It has the following structure:
+//
+//	{
+//	  "version": 1,
+//	  "app": "<application name>",
+//	  "registries": [
+//	    {
+//	      "key": "<registry key>",
+//	      "name": "<human-readable registry name>",
+//	      "url": "<documentation URL, optional>"
+//	    }
+//	  ],
+//	  "variables": [
+//	    {
+//	      "name": "<variable name>",
+//	      "description": "<variable description>",
+//	      "registry": "<registry key, omitted for the application's own variables>",
+//	      "required": true|false,
+//	      "sensitive": true|false,
+//	      "deprecated": true|false,
+//	      "defined_by_kubernetes": true|false,
+//	      "has_default": true|false,
+//	      "default": "<default value, omitted if sensitive>",
+//	      "file_variable": "<name of the _FILE variable, optional>",
+//	      "aliases": ["<alternative variable name>"],
+//	      "schema": { <schema> },
+//	      "constraints": [
+//	        { "description": "<description>", "user_defined": true|false }
+//	      ],
+//	      "examples": [
+//	        {
+//	          "canonical": "<value>",
+//	          "description": "<description>",
+//	          "normative": true|false,
+//	          "source": "unknown"|"schema"|"spec_builder"|"spec_default"
+//	        }
+//	      ],
+//	      "documentation": [
+//	        {
+//	          "summary": "<plain-text summary>",
+//	          "paragraphs": ["<paragraph>"],
+//	          "important": true|false
+//	        }
+//	      ],
+//	      "relationships": {
+//	        "refers_to": ["<variable name>"],
+//	        "depends_on": ["<variable name>"],
+//	        "required_if": [
+//	          {
+//	            "variable": "<variable name>",
+//	            "condition": "<description of the condition, optional>"
+//	          }
+//	        ],
+//	        "supersedes": ["<variable name>"],
+//	        "superseded_by": ["<variable name>"]
+//	      }
+//	    }
+//	  ],
+//	  "groups": [
+//	    {
+//	      "constraint": "exactly_one"|"at_most_one"|"at_least_one"|"all_or_none",
+//	      "members": [
+//	        ["<variable name>"]
+//	      ]
+//	    }
+//	  ]
+//	}
+//
+// Each group describes a constraint on which of its members are defined, as
+// per ExactlyOneOf(), AtMostOneOf(), AtLeastOneOf() and AllOrNone(). Each
+// member is a list of the names of the variables in one variable set.
+//
+// Each schema has a "kind" property that determines its remaining properties:
+//
+//   - "binary": "encoding", "min_length", "max_length"
+//   - "map": "key" and "value" (each a nested schema), "pair_separator",
+//     "entry_separator", "required_keys"
+//   - "numeric": "min", "max", "bits"
+//   - "set": "members"
+//   - "slice": "element" (a nested schema), "separator", "unique",
+//     "min_length", "max_length"
+//   - "string": "min_length", "max_length", "pattern"
+//   - "other": no additional properties
+//
+// All schemas also have a "type" property containing the name of the Go type
+// of the native value. Optional properties are omitted when they do not apply.
+package json
@@ -0,0 +1,365 @@
+package json
+
+import (
+	"encoding/json"
+	"path/filepath"
+
+	"github.com/dogmatiq/ferrite/internal/mode"
+	"github.com/dogmatiq/ferrite/internal/variable"
+)
+
+// Version is the version of the JSON format produced by this mode.
+const Version = 1
+
+// Run generates a machine-readable description of the environment variables
+// in JSON format.
+func Run(cfg mode.Config) {
+	doc := document{
+		Version:    Version,
+		App:        filepath.Base(cfg.Args[0]),
+		Registries: []registry{},
+		Variables:  []spec{},
+		Groups:     []group{},
+	}
+
+	for _, r := range cfg.Registries.Registries() {
+		if r.IsDefault {
+			continue
+		}
+
+		reg := registry{
+			Key:  r.Key,
+			Name: r.Name,
+		}
+
+		if r.URL != nil {
+			reg.URL = r.URL.String()
+		}
+
+		doc.Registries = append(doc.Registries, reg)
+	}
+
+	for _, v := range cfg.Registries.Variables() {
+		sp := specOf(v.Spec())
+
+		if n, ok := v.FileVariableName(); ok {
+			sp.FileVariable = n
+		}
+
+		if !v.Registry.IsDefault {
+			sp.Registry = v.Registry.Key
+		}
+
+		doc.Variables = append(doc.Variables, sp)
+	}
+
+	for _, g := range cfg.Registries.Groups() {
+		doc.Groups = append(doc.Groups, groupOf(g))
+	}
+
+	enc := json.NewEncoder(cfg.Out)
+	enc.SetIndent("", "  ")
+
+	if err := enc.Encode(doc); err != nil {
+		panic(err)
+	}
+
+	cfg.Exit(0)
+}
+
+type document struct {
+	Version    int        `json:"version"`
+	App        string     `json:"app"`
+	Registries []registry `json:"registries"`
+	Variables  []spec     `json:"variables"`
+	Groups     []group    `json:"groups"`
+}
+
+type registry struct {
+	Key  string `json:"key"`
+	Name string `json:"name"`
+	URL  string `json:"url,omitempty"`
+}
+
+type spec struct {
+	Name                  string          `json:"name"`
+	Description           string          `json:"description"`
+	Registry              string          `json:"registry,omitempty"`
+	IsRequired            bool            `json:"required"`
+	IsSensitive           bool            `json:"sensitive"`
+	IsDeprecated          bool            `json:"deprecated"`
+	IsDefinedByKubernetes bool            `json:"defined_by_kubernetes"`
+	HasDefault            bool            `json:"has_default"`
+	Default               *string         `json:"default,omitempty"`
+	FileVariable          string          `json:"file_variable,omitempty"`
+	Aliases               []string        `json:"aliases"`
+	Schema                *schema         `json:"schema"`
+	Constraints           []constraint    `json:"constraints"`
+	Examples              []example       `json:"examples"`
+	Documentation         []documentation `json:"documentation"`
+	Relationships         relationships   `json:"relationships"`
+}
+
+type schema struct {
+	Kind           string   `json:"kind"`
+	Type           string   `json:"type"`
+	Encoding       string   `json:"encoding,omitempty"`
+	Key            *schema  `json:"key,omitempty"`
+	Value          *schema  `json:"value,omitempty"`
+	PairSeparator  string   `json:"pair_separator,omitempty"`
+	EntrySeparator string   `json:"entry_separator,omitempty"`
+	RequiredKeys   []string `json:"required_keys,omitempty"`
+	Min            *string  `json:"min,omitempty"`
+	Max            *string  `json:"max,omitempty"`
+	Bits           int      `json:"bits,omitempty"`
+	Members        []string `json:"members,omitempty"`
+	Element        *schema  `json:"element,omitempty"`
+	Separator      string   `json:"separator,omitempty"`
+	IsUnique       bool     `json:"unique,omitempty"`
+	MinLength      *int     `json:"min_length,omitempty"`
+	MaxLength      *int     `json:"max_length,omitempty"`
+	Pattern        string   `json:"pattern,omitempty"`
+}
+
+type constraint struct {
+	Description   string `json:"description"`
+	IsUserDefined bool   `json:"user_defined"`
+}
+
+type example struct {
+	Canonical   string `json:"canonical"`
+	Description string `json:"description,omitempty"`
+	IsNormative bool   `json:"normative"`
+	Source      string `json:"source"`
+}
+
+type documentation struct {
+	Summary     string   `json:"summary,omitempty"`
+	Paragraphs  []string `json:"paragraphs"`
+	IsImportant bool     `json:"important"`
+}
+
+type relationships struct {
+	RefersTo     []string     `json:"refers_to"`
+	DependsOn    []string     `json:"depends_on"`
+	RequiredIf   []requiredIf `json:"required_if"`
+	Supersedes   []string     `json:"supersedes"`
+	SupersededBy []string     `json:"superseded_by"`
+}
+
+type requiredIf struct {
+	Variable  string `json:"variable"`
+	Condition string `json:"condition,omitempty"`
+}
+
+// specOf returns the JSON representation of s.
+func specOf(s variable.Spec) spec {
+	sp := spec{
+		Name:                  s.Name(),
+		Description:           s.Description(),
+		IsRequired:            s.IsRequired(),
+		IsSensitive:           s.IsSensitive(),
+		IsDeprecated:          s.IsDeprecated(),
+		IsDefinedByKubernetes: s.IsDefinedByKubernetes(),
+		Aliases:               append([]string{}, s.Aliases()...),
+		Schema:                schemaOf(s.Schema()),
+		Constraints:           []constraint{},
+		Examples:              []example{},
+		Documentation:         []documentation{},
+		Relationships: relationships{
+			RefersTo:     []string{},
+			DependsOn:    []string{},
+			RequiredIf:   []requiredIf{},
+			Supersedes:   []string{},
+			SupersededBy: []string{},
+		},
+	}
+
+	if def, ok := s.Default(); ok {
+		sp.HasDefault = true
+
+		if !s.IsSensitive() {
+			sp.Default = &def.String
+		}
+	}
+
+	for _, c := range s.Constraints() {
+		sp.Constraints = append(sp.Constraints, constraint{
+			Description:   c.Description(),
+			IsUserDefined: c.IsUserDefined(),
+		})
+	}
+
+	for _, eg := range s.Examples() {
+		// Don't reveal the default value of a sensitive variable by way of an
+		// example.
+		if s.IsSensitive() && variable.IsDefault(s, eg.Canonical) {
+			continue
+		}
+
+		sp.Examples = append(sp.Examples, example{
+			Canonical:   eg.Canonical.String,
+			Description: eg.Description,
+			IsNormative: eg.IsNormative,
+			Source:      exampleSources[eg.Source],
+		})
+	}
+
+	for _, d := range s.Documentation() {
+		sp.Documentation = append(sp.Documentation, documentation{
+			Summary:     d.Summary,
+			Paragraphs:  append([]string{}, d.Paragraphs...),
+			IsImportant: d.IsImportant,
+		})
+	}
+
+	for _, rel := range variable.Relationships[variable.RefersTo](s) {
+		sp.Relationships.RefersTo = append(sp.Relationships.RefersTo, rel.RefersTo.Name())
+	}
+
+	for _, rel := range variable.Relationships[variable.DependsOn](s) {
+		sp.Relationships.DependsOn = append(sp.Relationships.DependsOn, rel.DependsOn.Name())
+	}
+
+	for _, rel := range variable.Relationships[variable.RequiredIf](s) {
+		sp.Relationships.RequiredIf = append(sp.Relationships.RequiredIf, requiredIf{
+			Variable:  rel.RequiredIf.Name(),
+			Condition: rel.Condition,
+		})
+	}
+
+	for _, rel := range variable.Relationships[variable.Supersedes](s) {
+		sp.Relationships.Supersedes = append(sp.Relationships.Supersedes, rel.Supersedes.Name())
+	}
+
+	for _, rel := range variable.InverseRelationships[variable.Supersedes](s) {
+		sp.Relationships.SupersededBy = append(sp.Relationships.SupersededBy, rel.Subject.Name())
+	}
+
+	return sp
+}
+
+type group struct {
+	Constraint string     `json:"constraint"`
+	Members    [][]string `json:"members"`
+}
+
+// groupOf returns the JSON representation of g.
+func groupOf(g *variable.Group) group {
+	gr := group{
+		Constraint: groupConstraints[g.Constraint],
+		Members:    [][]string{},
+	}
+
+	for _, m := range g.Members {
+		names := []string{}
+		for _, v := range m {
+			names = append(names, v.Spec().Name())
+		}
+		gr.Members = append(gr.Members, names)
+	}
+
+	return gr
+}
+
+var groupConstraints = map[variable.GroupConstraint]string{
+	variable.ExactlyOne: "exactly_one",
+	variable.AtMostOne:  "at_most_one",
+	variable.AtLeastOne: "at_least_one",
+	variable.AllOrNone:  "all_or_none",
+}
+
+var exampleSources = map[variable.ExampleSource]string{
+	variable.ExampleSourceUnknown:     "unknown",
+	variable.ExampleSourceSchema:      "schema",
+	variable.ExampleSourceSpecBuilder: "spec_builder",
+	variable.ExampleSourceSpecDefault: "spec_default",
+}
+
+// schemaOf returns the JSON representation of s.
+func schemaOf(s variable.Schema) *schema {
+	b := &schemaBuilder{
+		Schema: &schema{
+			Type: s.Type().String(),
+		},
+	}
+
+	s.AcceptVisitor(b)
+
+	return b.Schema
+}
+
+// schemaBuilder populates a schema based on a variable's schema.
+type schemaBuilder struct {
+	Schema *schema
+}
+
+func (b *schemaBuilder) VisitBinary(s variable.Binary) {
+	b.Schema.Kind = "binary"
+	b.Schema.Encoding = s.EncodingDescription()
+	b.setLengthLimits(s)
+}
+
+func (b *schemaBuilder) VisitMap(s variable.Map) {
+	b.Schema.Kind = "map"
+	b.Schema.Key = schemaOf(s.Key().Schema())
+	b.Schema.Value = schemaOf(s.Value().Schema())
+	b.Schema.PairSeparator = s.PairSeparator()
+	b.Schema.EntrySeparator = s.EntrySeparator()
+
+	for _, k := range s.RequiredKeys() {
+		b.Schema.RequiredKeys = append(b.Schema.RequiredKeys, k.String)
+	}
+}
+
+func (b *schemaBuilder) VisitNumeric(s variable.Numeric) {
+	b.Schema.Kind = "numeric"
+	b.Schema.Bits = s.Bits()
+
+	if min, ok := s.Min(); ok {
+		b.Schema.Min = &min.String
+	}
+
+	if max, ok := s.Max(); ok {
+		b.Schema.Max = &max.String
+	}
+}
+
+func (b *schemaBuilder) VisitSet(s variable.Set) {
+	b.Schema.Kind = "set"
+
+	for _, lit := range s.Literals() {
+		b.Schema.Members = append(b.Schema.Members, lit.String)
+	}
+}
+
+func (b *schemaBuilder) VisitSlice(s variable.Slice) {
+	b.Schema.Kind = "slice"
+	b.Schema.Element = schemaOf(s.Element().Schema())
+	b.Schema.Separator = s.Separator()
+	b.Schema.IsUnique = s.IsUnique()
+	b.setLengthLimits(s)
+}
+
+func (b *schemaBuilder) VisitString(s variable.String) {
+	b.Schema.Kind = "string"
+	b.setLengthLimits(s)
+
+	if re, ok := s.Pattern(); ok {
+		b.Schema.Pattern = re.String()
+	}
+}
+
+func (b *schemaBuilder) VisitOther(variable.Other) {
+	b.Schema.Kind = "other"
+}
+
+func (b *schemaBuilder) setLengthLimits(s variable.LengthLimited) {
+	if n, ok := s.MinLength(); ok {
+		b.Schema.MinLength = &n
+	}
+
+	if n, ok := s.MaxLength(); ok {
+		b.Schema.MaxLength = &n
+	}
+}
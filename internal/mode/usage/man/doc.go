@@ -0,0 +1,3 @@
+// Package man is a Ferrite mode that renders environment variable usage
+// instructions as the ENVIRONMENT section of a manual page, in roff format.
+package man
@@ -0,0 +1,86 @@
+package man
+
+import (
+	"strings"
+
+	"github.com/dogmatiq/ferrite/internal/mode"
+	"github.com/dogmatiq/ferrite/internal/mode/usage/internal/markup"
+	"github.com/dogmatiq/ferrite/internal/mode/usage/markdown"
+	"github.com/dogmatiq/iago/must"
+)
+
+// Run generates environment variable usage instructions as the ENVIRONMENT
+// section of a manual page.
+func Run(cfg mode.Config) {
+	var md strings.Builder
+	markdown.RenderSpecs(&md, cfg.Registries.Variables())
+
+	must.WriteString(cfg.Out, ".SH ENVIRONMENT\n")
+
+	for _, b := range markup.Parse(md.String()) {
+		switch b.Kind {
+		case markup.VariableHeading:
+			must.WriteString(cfg.Out, ".TP\n")
+			must.WriteString(cfg.Out, ".B "+escape(markup.PlainText(b.Text))+"\n")
+		case markup.Description:
+			must.WriteString(cfg.Out, line(b.Text))
+		case markup.Heading:
+			must.WriteString(cfg.Out, ".IP\n")
+			must.WriteString(cfg.Out, ".I "+escape(markup.PlainText(b.Text))+"\n")
+		case markup.Paragraph:
+			must.WriteString(cfg.Out, ".IP\n")
+			must.WriteString(cfg.Out, line(b.Text))
+		case markup.ListItem:
+			must.WriteString(cfg.Out, ".IP\n")
+			must.WriteString(cfg.Out, `\(bu `+line(b.Text))
+		case markup.Code:
+			must.WriteString(cfg.Out, ".IP\n")
+			must.WriteString(cfg.Out, ".nf\n")
+			for _, l := range b.Lines {
+				must.WriteString(cfg.Out, protect(escape(l))+"\n")
+			}
+			must.WriteString(cfg.Out, ".fi\n")
+		}
+	}
+
+	cfg.Exit(0)
+}
+
+// line returns a line of roff text containing the given text with its inline
+// formatting converted to roff font escapes.
+func line(text string) string {
+	var w strings.Builder
+
+	// Remove any emoji used to draw attention to the text, as they are not
+	// rendered reliably within a terminal.
+	text = strings.ReplaceAll(text, "⚠️ ", "")
+
+	for _, s := range markup.ParseInline(text) {
+		t := escape(s.Text)
+
+		if s.Style&markup.Literal != 0 {
+			t = strings.ReplaceAll(t, "-", `\-`)
+		}
+
+		if s.Style&(markup.Literal|markup.Strong) != 0 {
+			w.WriteString(`\fB` + t + `\fR`)
+		} else {
+			w.WriteString(t)
+		}
+	}
+
+	return protect(w.String()) + "\n"
+}
+
+// escape escapes any characters in text that have special meaning to roff.
+func escape(text string) string {
+	return strings.ReplaceAll(text, `\`, `\e`)
+}
+
+// protect prevents a line of text from being interpreted as a roff request.
+func protect(text string) string {
+	if strings.HasPrefix(text, ".") || strings.HasPrefix(text, "'") {
+		return `\&` + text
+	}
+	return text
+}
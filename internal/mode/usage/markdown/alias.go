@@ -0,0 +1,37 @@
+package markdown
+
+import "fmt"
+
+func (r *specRenderer) renderAliases() {
+	aliases := r.spec.Aliases()
+
+	switch len(aliases) {
+	case 0:
+		return
+
+	case 1:
+		r.ren.paragraphf(
+			"⚠️ `%s` is a **deprecated** alias for the `%s` variable.",
+			"Its value is used only when `%s` is undefined.",
+		)(
+			aliases[0],
+			r.spec.Name(),
+			r.spec.Name(),
+		)
+
+	default:
+		r.ren.paragraphf(
+			"⚠️ %s are **deprecated** aliases for the `%s` variable.",
+			"Their values are used, in that order, only when `%s` is undefined.",
+		)(
+			andList(
+				aliases,
+				func(n string) string {
+					return fmt.Sprintf("`%s`", n)
+				},
+			),
+			r.spec.Name(),
+			r.spec.Name(),
+		)
+	}
+}
@@ -0,0 +1,43 @@
+package markdown_test
+
+import (
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite/internal/mode/usage/markdown"
+	. "github.com/onsi/ginkgo/v2"
+)
+
+var _ = DescribeTable(
+	"func Run()",
+	tableTest(
+		"alias",
+		WithoutExplanatoryText(),
+		WithoutUsageExamples(),
+	),
+	Entry(
+		"single alias",
+		"single.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				String("LISTEN_HOST", "listen host for the HTTP server").
+				WithDefault("0.0.0.0").
+				Required(
+					ferrite.WithRegistry(reg),
+					ferrite.WithAlias("BIND_HOST"),
+				)
+		},
+	),
+	Entry(
+		"multiple aliases",
+		"multiple.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				String("LISTEN_HOST", "listen host for the HTTP server").
+				WithDefault("0.0.0.0").
+				Required(
+					ferrite.WithRegistry(reg),
+					ferrite.WithAlias("BIND_HOST"),
+					ferrite.WithAlias("HTTP_HOST"),
+				)
+		},
+	),
+)
@@ -1,6 +1,7 @@
 package markdown
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/dogmatiq/ferrite/internal/variable"
@@ -44,3 +45,34 @@ func (r *renderer) renderSeeAlsoItem(s variable.Spec) {
 
 	r.line(w.String())
 }
+
+func (r *specRenderer) renderGroups() {
+	for _, g := range variable.Groups(r.spec) {
+		specs := g.Specs()
+
+		names := andList(
+			specs,
+			func(s variable.Spec) string {
+				if s == r.spec {
+					return fmt.Sprintf("`%s`", s.Name())
+				}
+				return r.ren.linkToSpec(s)
+			},
+		)
+
+		switch g.Constraint {
+		case variable.ExactlyOne:
+			r.ren.paragraphf("Exactly one of %s **MUST** be defined.")(names)
+		case variable.AtMostOne:
+			r.ren.paragraphf("No more than one of %s **MAY** be defined.")(names)
+		case variable.AtLeastOne:
+			r.ren.paragraphf("At least one of %s **MUST** be defined.")(names)
+		case variable.AllOrNone:
+			if len(g.Members) == 2 {
+				r.ren.paragraphf("Either both or neither of %s **MUST** be defined.")(names)
+			} else {
+				r.ren.paragraphf("Either all or none of %s **MUST** be defined.")(names)
+			}
+		}
+	}
+}
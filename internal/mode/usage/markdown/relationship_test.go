@@ -145,6 +145,70 @@ var _ = DescribeTable(
 				)
 		},
 	),
+	Entry(
+		"depends on + regexp",
+		"depends-on/regexp.md",
+		func(reg ferrite.Registry) {
+			filter := ferrite.
+				Regexp("WIDGET_FILTER", "the pattern used to select widgets").
+				Optional(ferrite.WithRegistry(reg))
+
+			ferrite.
+				String("WIDGET_COLOR", "the color of the selected widgets").
+				Optional(
+					ferrite.WithRegistry(reg),
+					ferrite.RelevantIf(filter),
+				)
+		},
+	),
+	Entry(
+		"depends on + ipaddr",
+		"depends-on/ipaddr.md",
+		func(reg ferrite.Registry) {
+			bindAddr := ferrite.
+				IPAddr("WIDGET_BIND_ADDR", "the address the widget server binds to").
+				Optional(ferrite.WithRegistry(reg))
+
+			ferrite.
+				NetworkPort("WIDGET_PORT", "the port the widget server listens on").
+				Optional(
+					ferrite.WithRegistry(reg),
+					ferrite.RelevantIf(bindAddr),
+				)
+		},
+	),
+	Entry(
+		"depends on + prefix",
+		"depends-on/prefix.md",
+		func(reg ferrite.Registry) {
+			allowed := ferrite.
+				Prefix("WIDGET_ALLOWED_NETWORK", "the network allowed to access widgets").
+				Optional(ferrite.WithRegistry(reg))
+
+			ferrite.
+				String("WIDGET_DENY_MESSAGE", "the message shown to clients outside the allowed network").
+				Optional(
+					ferrite.WithRegistry(reg),
+					ferrite.RelevantIf(allowed),
+				)
+		},
+	),
+	Entry(
+		"depends on + address",
+		"depends-on/address.md",
+		func(reg ferrite.Registry) {
+			upstream := ferrite.
+				Address("WIDGET_UPSTREAM", "the address of the upstream widget server").
+				Optional(ferrite.WithRegistry(reg))
+
+			ferrite.
+				Duration("WIDGET_UPSTREAM_TIMEOUT", "the timeout for requests to the upstream widget server").
+				Optional(
+					ferrite.WithRegistry(reg),
+					ferrite.RelevantIf(upstream),
+				)
+		},
+	),
 	Entry(
 		"depends on + deprecated",
 		"depends-on/deprecated.md",
@@ -161,4 +225,236 @@ var _ = DescribeTable(
 				)
 		},
 	),
+	Entry(
+		"relevant if + optional",
+		"relevant-if/optional.md",
+		func(reg ferrite.Registry) {
+			storage := ferrite.
+				Enum("STORAGE", "the storage backend").
+				WithMembers("disk", "s3").
+				Required(ferrite.WithRegistry(reg))
+
+			ferrite.
+				String("S3_BUCKET", "the name of the S3 bucket").
+				Optional(
+					ferrite.WithRegistry(reg),
+					ferrite.RelevantIf(storage, ferrite.WhenEqual("s3")),
+				)
+		},
+	),
+	Entry(
+		"relevant if + required",
+		"relevant-if/required.md",
+		func(reg ferrite.Registry) {
+			storage := ferrite.
+				Enum("STORAGE", "the storage backend").
+				WithMembers("disk", "s3").
+				Required(ferrite.WithRegistry(reg))
+
+			ferrite.
+				String("S3_BUCKET", "the name of the S3 bucket").
+				Required(
+					ferrite.WithRegistry(reg),
+					ferrite.RelevantIf(storage, ferrite.WhenEqual("s3")),
+				)
+		},
+	),
+	Entry(
+		"relevant if + default",
+		"relevant-if/with-default.md",
+		func(reg ferrite.Registry) {
+			storage := ferrite.
+				Enum("STORAGE", "the storage backend").
+				WithMembers("disk", "s3").
+				Required(ferrite.WithRegistry(reg))
+
+			ferrite.
+				String("S3_BUCKET", "the name of the S3 bucket").
+				WithDefault("my-bucket").
+				Required(
+					ferrite.WithRegistry(reg),
+					ferrite.RelevantIf(storage, ferrite.WhenEqual("s3")),
+				)
+		},
+	),
+	Entry(
+		"relevant if + deprecated",
+		"relevant-if/deprecated.md",
+		func(reg ferrite.Registry) {
+			storage := ferrite.
+				Enum("STORAGE", "the storage backend").
+				WithMembers("disk", "s3").
+				Required(ferrite.WithRegistry(reg))
+
+			ferrite.
+				String("S3_BUCKET", "the name of the S3 bucket").
+				Deprecated(
+					ferrite.WithRegistry(reg),
+					ferrite.RelevantIf(storage, ferrite.WhenEqual("s3")),
+				)
+		},
+	),
+	Entry(
+		"relevant if + predicate",
+		"relevant-if/predicate.md",
+		func(reg ferrite.Registry) {
+			storage := ferrite.
+				Enum("STORAGE", "the storage backend").
+				WithMembers("disk", "s3").
+				Required(ferrite.WithRegistry(reg))
+
+			ferrite.
+				String("S3_BUCKET", "the name of the S3 bucket").
+				Required(
+					ferrite.WithRegistry(reg),
+					ferrite.RelevantIf(
+						storage,
+						ferrite.WhenFunc(func(s string) bool { return s != "disk" }),
+					),
+				)
+		},
+	),
+	Entry(
+		"relevant if + predicate + description",
+		"relevant-if/predicate-with-description.md",
+		func(reg ferrite.Registry) {
+			storage := ferrite.
+				Enum("STORAGE", "the storage backend").
+				WithMembers("disk", "s3").
+				Required(ferrite.WithRegistry(reg))
+
+			ferrite.
+				String("S3_BUCKET", "the name of the S3 bucket").
+				Required(
+					ferrite.WithRegistry(reg),
+					ferrite.RelevantIf(
+						storage,
+						ferrite.WhenFunc(func(s string) bool { return s != "disk" }),
+						ferrite.WithConditionDescription("is not `disk`"),
+					),
+				)
+		},
+	),
+	Entry(
+		"required if",
+		"required-if/described.md",
+		func(reg ferrite.Registry) {
+			tlsMode := ferrite.
+				Enum("TLS_MODE", "the TLS mode").
+				WithMembers("none", "auto", "manual").
+				Required(ferrite.WithRegistry(reg))
+
+			ferrite.
+				File("TLS_CERT", "path to the TLS certificate").
+				Optional(
+					ferrite.WithRegistry(reg),
+					ferrite.RequiredIf(
+						tlsMode,
+						func(m string) bool { return m == "manual" },
+						ferrite.WithConditionDescription("is `manual`"),
+					),
+				)
+		},
+	),
+	Entry(
+		"required if (without description)",
+		"required-if/undescribed.md",
+		func(reg ferrite.Registry) {
+			tlsMode := ferrite.
+				Enum("TLS_MODE", "the TLS mode").
+				WithMembers("none", "auto", "manual").
+				Required(ferrite.WithRegistry(reg))
+
+			ferrite.
+				File("TLS_CERT", "path to the TLS certificate").
+				Optional(
+					ferrite.WithRegistry(reg),
+					ferrite.RequiredIf(
+						tlsMode,
+						func(m string) bool { return m == "manual" },
+					),
+				)
+		},
+	),
+	Entry(
+		"exactly one of",
+		"group/exactly-one-of.md",
+		func(reg ferrite.Registry) {
+			url := ferrite.
+				URL("DATABASE_URL", "the URL of the database").
+				Optional(ferrite.WithRegistry(reg))
+
+			host := ferrite.
+				String("DATABASE_HOST", "the hostname of the database").
+				Optional(ferrite.WithRegistry(reg))
+
+			ferrite.ExactlyOneOf(url, host)
+		},
+	),
+	Entry(
+		"at most one of",
+		"group/at-most-one-of.md",
+		func(reg ferrite.Registry) {
+			url := ferrite.
+				URL("DATABASE_URL", "the URL of the database").
+				Optional(ferrite.WithRegistry(reg))
+
+			host := ferrite.
+				String("DATABASE_HOST", "the hostname of the database").
+				Optional(ferrite.WithRegistry(reg))
+
+			ferrite.AtMostOneOf(url, host)
+		},
+	),
+	Entry(
+		"at least one of",
+		"group/at-least-one-of.md",
+		func(reg ferrite.Registry) {
+			url := ferrite.
+				URL("DATABASE_URL", "the URL of the database").
+				Optional(ferrite.WithRegistry(reg))
+
+			host := ferrite.
+				String("DATABASE_HOST", "the hostname of the database").
+				Optional(ferrite.WithRegistry(reg))
+
+			ferrite.AtLeastOneOf(url, host)
+		},
+	),
+	Entry(
+		"all or none",
+		"group/all-or-none.md",
+		func(reg ferrite.Registry) {
+			host := ferrite.
+				String("DATABASE_HOST", "the hostname of the database").
+				Optional(ferrite.WithRegistry(reg))
+
+			user := ferrite.
+				String("DATABASE_USER", "the username used to connect to the database").
+				Optional(ferrite.WithRegistry(reg))
+
+			password := ferrite.
+				String("DATABASE_PASSWORD", "the password used to connect to the database").
+				WithSensitiveContent().
+				Optional(ferrite.WithRegistry(reg))
+
+			ferrite.AllOrNone(host, user, password)
+		},
+	),
+	Entry(
+		"all or none (pair)",
+		"group/all-or-none-pair.md",
+		func(reg ferrite.Registry) {
+			user := ferrite.
+				String("DATABASE_USER", "the username used to connect to the database").
+				Optional(ferrite.WithRegistry(reg))
+
+			password := ferrite.
+				String("DATABASE_PASSWORD", "the password used to connect to the database").
+				WithSensitiveContent().
+				Optional(ferrite.WithRegistry(reg))
+
+			ferrite.AllOrNone(user, password)
+		},
+	),
 )
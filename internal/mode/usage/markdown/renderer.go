@@ -80,9 +80,9 @@ func (r *renderer) Render() {
 
 		for _, v := range r.Variables {
 			sr := specRenderer{
-				r,
-				v.Spec(),
-				v.Registry,
+				ren:  r,
+				spec: v.Spec(),
+				reg:  v.Registry,
 			}
 			sr.Render()
 		}
@@ -1,9 +1,11 @@
 package markdown
 
 import (
+	"io"
 	"path/filepath"
 
 	"github.com/dogmatiq/ferrite/internal/mode"
+	"github.com/dogmatiq/ferrite/internal/variable"
 )
 
 // Run generates environment variable usage instructions in markdown format.
@@ -22,6 +24,28 @@ func Run(cfg mode.Config, options ...Option) {
 	cfg.Exit(0)
 }
 
+// RenderSpecs renders the specification of each of the given variables to w,
+// without any of the surrounding document structure, such as the index and the
+// link references.
+//
+// It allows other usage modes to describe variables in the same way as this
+// mode by converting the Markdown into another format.
+func RenderSpecs(w io.Writer, vars []variable.RegisteredVariable) {
+	r := renderer{
+		Variables: vars,
+		Output:    w,
+	}
+
+	for _, v := range vars {
+		sr := specRenderer{
+			ren:  &r,
+			spec: v.Spec(),
+			reg:  v.Registry,
+		}
+		sr.Render()
+	}
+}
+
 // Option is a function that changes the behavior of a renderer.
 type Option func(*renderer)
 
@@ -3,6 +3,7 @@ package markdown
 import (
 	"fmt"
 	"reflect"
+	"strings"
 
 	"github.com/dogmatiq/ferrite/internal/variable"
 )
@@ -11,6 +12,11 @@ type specRenderer struct {
 	ren  *renderer
 	spec variable.Spec
 	reg  *variable.Registry
+
+	// capture, if non-nil, is called with the primary requirement text instead
+	// of rendering it. It is used to describe the elements of composite
+	// values, such as slices.
+	capture func(req string)
 }
 
 func (r *specRenderer) Render() {
@@ -22,6 +28,8 @@ func (r *specRenderer) Render() {
 
 	r.spec.Schema().AcceptVisitor(r)
 
+	r.renderGroups()
+	r.renderAliases()
 	r.renderImportantDocumentation()
 
 	if r.spec.IsSensitive() {
@@ -41,13 +49,60 @@ func (r *specRenderer) Render() {
 // VisitBinary renders the primary requirement for a spec that uses the
 // "binary" schema type.
 func (r *specRenderer) VisitBinary(s variable.Binary) {
-	if con := r.bestConstraint(); con != nil {
+	if n, ok := renderLength(s, "bytes"); ok {
+		r.renderPrimaryRequirement(
+			"**MUST** be a binary value containing %s, expressed using the `%s` encoding scheme",
+			n,
+			s.EncodingDescription(),
+		)
+	} else if con := r.bestConstraint(); con != nil {
 		r.renderPrimaryRequirement(con.Description())
 	} else {
 		r.renderPrimaryRequirement("**MUST** be a binary value expressed using the `%s` encoding scheme", s.EncodingDescription())
 	}
 }
 
+// VisitMap renders the primary requirement for a spec that uses the "map"
+// schema type.
+func (r *specRenderer) VisitMap(s variable.Map) {
+	var w strings.Builder
+
+	fmt.Fprintf(
+		&w,
+		"**MUST** be a list of key/value pairs separated by `%s`, with each key separated from its value by `%s`",
+		strings.TrimSpace(s.EntrySeparator()),
+		strings.TrimSpace(s.PairSeparator()),
+	)
+
+	keyReq := r.renderElementRequirement(s.Key())
+	valueReq := r.renderElementRequirement(s.Value())
+
+	if keyReq != "" && valueReq != "" {
+		fmt.Fprintf(&w, ", where each key %s and each value %s", keyReq, valueReq)
+	} else if keyReq != "" {
+		fmt.Fprintf(&w, ", where each key %s", keyReq)
+	} else if valueReq != "" {
+		fmt.Fprintf(&w, ", where each value %s", valueReq)
+	}
+
+	if keys := s.RequiredKeys(); len(keys) == 1 {
+		fmt.Fprintf(&w, "; the `%s` key **MUST** be present", keys[0].String)
+	} else if len(keys) > 1 {
+		fmt.Fprintf(
+			&w,
+			"; the %s keys **MUST** be present",
+			andList(
+				keys,
+				func(lit variable.Literal) string {
+					return fmt.Sprintf("`%s`", lit.String)
+				},
+			),
+		)
+	}
+
+	r.renderPrimaryRequirement("%s", w.String())
+}
+
 // VisitNumeric renders the primary requirement for a spec that uses the
 // "numeric" schema type.
 func (r *specRenderer) VisitNumeric(s variable.Numeric) {
@@ -75,21 +130,81 @@ func (r *specRenderer) VisitNumeric(s variable.Numeric) {
 // VisitSet renders the primary requirement for a spec that uses the "set"
 // schema type.
 func (r *specRenderer) VisitSet(s variable.Set) {
-	if lits := s.Literals(); len(lits) == 2 {
+	lits := s.Literals()
+
+	if len(lits) == 2 {
 		r.renderPrimaryRequirement(
 			"**MUST** be either `%s` or `%s`",
 			lits[0].String,
 			lits[1].String,
 		)
+	} else if r.capture != nil {
+		// The examples of a composite value do not necessarily show every
+		// member of the set, so list them explicitly.
+		r.renderPrimaryRequirement(
+			"**MUST** be one of %s",
+			orList(
+				lits,
+				func(lit variable.Literal) string {
+					return fmt.Sprintf("`%s`", lit.String)
+				},
+			),
+		)
 	} else {
 		r.renderPrimaryRequirement("**MUST** be one of the values shown in the examples below")
 	}
 }
 
+// VisitSlice renders the primary requirement for a spec that uses the "slice"
+// schema type.
+func (r *specRenderer) VisitSlice(s variable.Slice) {
+	var w strings.Builder
+
+	fmt.Fprintf(
+		&w,
+		"**MUST** be a list of values separated by `%s`",
+		strings.TrimSpace(s.Separator()),
+	)
+
+	if n, ok := renderLength(s, "values"); ok {
+		fmt.Fprintf(&w, " containing %s", n)
+	}
+
+	if req := r.renderElementRequirement(s.Element()); req != "" {
+		fmt.Fprintf(&w, ", each of which %s", req)
+	}
+
+	if s.IsUnique() {
+		w.WriteString("; each value **MUST** be unique")
+	}
+
+	r.renderPrimaryRequirement("%s", w.String())
+}
+
 // VisitString renders the primary requirement for a spec that uses the "string"
 // schema type.
-func (r *specRenderer) VisitString(variable.String) {
-	if con := r.bestConstraint(); con != nil {
+func (r *specRenderer) VisitString(s variable.String) {
+	var reqs []string
+
+	if n, ok := renderLength(s, "bytes"); ok {
+		reqs = append(reqs, fmt.Sprintf("**MUST** be %s long", n))
+	}
+
+	if re, ok := s.Pattern(); ok {
+		reqs = append(reqs, fmt.Sprintf("**MUST** match the regular expression `%s`", re))
+	}
+
+	if len(reqs) != 0 {
+		r.renderPrimaryRequirement(
+			"%s",
+			andList(
+				reqs,
+				func(req string) string {
+					return req
+				},
+			),
+		)
+	} else if con := r.bestConstraint(); con != nil {
 		r.renderPrimaryRequirement(con.Description())
 	} else {
 		r.renderPrimaryRequirement("")
@@ -99,6 +214,26 @@ func (r *specRenderer) VisitString(variable.String) {
 // VisitOther render the primary requirement for a spec that uses the "other"
 // schema type.
 func (r *specRenderer) VisitOther(s variable.Other) {
+	var builtIn []variable.Constraint
+	for _, c := range r.spec.Constraints() {
+		if !c.IsUserDefined() {
+			builtIn = append(builtIn, c)
+		}
+	}
+
+	if len(builtIn) != 0 {
+		r.renderPrimaryRequirement(
+			"%s",
+			andList(
+				builtIn,
+				func(c variable.Constraint) string {
+					return c.Description()
+				},
+			),
+		)
+		return
+	}
+
 	con := ""
 	for _, c := range r.spec.Constraints() {
 		con = c.Description()
@@ -108,6 +243,26 @@ func (r *specRenderer) VisitOther(s variable.Other) {
 	r.renderPrimaryRequirement(con)
 }
 
+// renderLength returns a description of the length limits of s, such as
+// "between 1 and 10 bytes", or false if s has no length limits.
+func renderLength(s variable.LengthLimited, unit string) (string, bool) {
+	min, hasMin := s.MinLength()
+	max, hasMax := s.MaxLength()
+
+	if hasMin && hasMax {
+		if min == max {
+			return fmt.Sprintf("exactly %d %s", min, unit), true
+		}
+		return fmt.Sprintf("between %d and %d %s", min, max, unit), true
+	} else if hasMin {
+		return fmt.Sprintf("at least %d %s", min, unit), true
+	} else if hasMax {
+		return fmt.Sprintf("no more than %d %s", max, unit), true
+	}
+
+	return "", false
+}
+
 // renderPrimaryRequirement renders information about the most important
 // requirement of the variable's schema, this includes information about whether
 // the variable is optional and the basic data type of the variable.
@@ -117,6 +272,11 @@ func (r *specRenderer) VisitOther(s variable.Other) {
 func (r *specRenderer) renderPrimaryRequirement(f string, v ...any) {
 	req := fmt.Sprintf(f, v...)
 
+	if r.capture != nil {
+		r.capture(req)
+		return
+	}
+
 	if r.spec.IsDeprecated() {
 		r.renderPrimaryRequirementDeprecated(req)
 	} else if def, ok := r.renderDefaultValueFragment(); ok {
@@ -128,6 +288,23 @@ func (r *specRenderer) renderPrimaryRequirement(f string, v ...any) {
 	}
 }
 
+// renderElementRequirement returns the primary requirement text for the
+// elements of a composite value, such as a slice or map.
+func (r *specRenderer) renderElementRequirement(s variable.Spec) string {
+	var req string
+
+	s.Schema().AcceptVisitor(&specRenderer{
+		ren:  r.ren,
+		spec: s,
+		reg:  r.reg,
+		capture: func(x string) {
+			req = x
+		},
+	})
+
+	return req
+}
+
 func (r *specRenderer) renderPrimaryRequirementDefault(req, def string) {
 	r.ren.paragraph(
 		func(write func(string, ...any)) {
@@ -144,6 +321,10 @@ func (r *specRenderer) renderPrimaryRequirementDefault(req, def string) {
 			if dep := r.renderDependsOnClause(); dep != "" {
 				write(" The value is not used when %s.", dep)
 			}
+
+			if rel := r.renderRelevantIfClause(); rel != "" {
+				write(" The value is only used when %s.", rel)
+			}
 		},
 	)
 }
@@ -151,6 +332,8 @@ func (r *specRenderer) renderPrimaryRequirementDefault(req, def string) {
 func (r *specRenderer) renderPrimaryRequirementRequired(req string) {
 	r.ren.paragraph(
 		func(write func(string, ...any)) {
+			relevantIf := r.renderRelevantIfClause()
+
 			if dependsOn := r.renderDependsOnClause(); dependsOn != "" {
 				write(
 					"The `%s` variable **MAY** be left undefined if and only if %s.",
@@ -162,6 +345,24 @@ func (r *specRenderer) renderPrimaryRequirementRequired(req string) {
 					write(" Otherwise, the value %s.", req)
 				}
 
+				if relevantIf != "" {
+					write(" The value is only used when %s.", relevantIf)
+				}
+
+				return
+			}
+
+			if relevantIf != "" {
+				write(
+					"The `%s` variable **MUST NOT** be left undefined when %s; otherwise, it **MAY** be left undefined.",
+					r.spec.Name(),
+					relevantIf,
+				)
+
+				if req != "" {
+					write(" If defined, the value %s.", req)
+				}
+
 				return
 			}
 
@@ -177,18 +378,34 @@ func (r *specRenderer) renderPrimaryRequirementRequired(req string) {
 func (r *specRenderer) renderPrimaryRequirementOptional(req string) {
 	r.ren.paragraph(
 		func(write func(string, ...any)) {
-			write(
-				"The `%s` variable **MAY** be left undefined.",
-				r.spec.Name(),
-			)
+			if requiredIf := r.renderRequiredIfClause(); requiredIf != "" {
+				write(
+					"The `%s` variable **MUST NOT** be left undefined when %s; otherwise, it **MAY** be left undefined.",
+					r.spec.Name(),
+					requiredIf,
+				)
 
-			if req != "" {
-				write(" Otherwise, the value %s.", req)
+				if req != "" {
+					write(" If defined, the value %s.", req)
+				}
+			} else {
+				write(
+					"The `%s` variable **MAY** be left undefined.",
+					r.spec.Name(),
+				)
+
+				if req != "" {
+					write(" Otherwise, the value %s.", req)
+				}
 			}
 
 			if dep := r.renderDependsOnClause(); dep != "" {
 				write(" The value is not used when %s.", dep)
 			}
+
+			if rel := r.renderRelevantIfClause(); rel != "" {
+				write(" The value is only used when %s.", rel)
+			}
 		},
 	)
 }
@@ -221,6 +438,10 @@ func (r *specRenderer) renderPrimaryRequirementDeprecated(req string) {
 			if dep := r.renderDependsOnClause(); dep != "" {
 				write(" The value is not used when %s.", dep)
 			}
+
+			if rel := r.renderRelevantIfClause(); rel != "" {
+				write(" The value is only used when %s.", rel)
+			}
 		},
 	)
 }
@@ -239,18 +460,79 @@ func (r *specRenderer) renderDefaultValueFragment() (string, bool) {
 }
 
 func (r *specRenderer) renderDependsOnClause() string {
+	var relationships []variable.DependsOn
+	for _, rel := range variable.Relationships[variable.DependsOn](r.spec) {
+		if !rel.IsConditional {
+			relationships = append(relationships, rel)
+		}
+	}
+
 	return orList(
-		variable.Relationships[variable.DependsOn](r.spec),
+		relationships,
 		func(rel variable.DependsOn) string {
+			zero := rel.DependsOn.Zero()
+
+			// Empty values are treated the same as undefined variables, so
+			// describe them as such rather than rendering an empty literal.
+			if zero.String == "" {
+				return fmt.Sprintf(
+					"%s is undefined",
+					r.ren.linkToSpec(rel.DependsOn),
+				)
+			}
+
 			return fmt.Sprintf(
 				"%s is `%s`",
 				r.ren.linkToSpec(rel.DependsOn),
-				rel.DependsOn.Zero().String,
+				zero.String,
 			)
 		},
 	)
 }
 
+func (r *specRenderer) renderRelevantIfClause() string {
+	var relationships []variable.DependsOn
+	for _, rel := range variable.Relationships[variable.DependsOn](r.spec) {
+		if rel.IsConditional {
+			relationships = append(relationships, rel)
+		}
+	}
+
+	return andList(
+		relationships,
+		func(rel variable.DependsOn) string {
+			return r.renderCondition(rel.DependsOn, rel.Condition)
+		},
+	)
+}
+
+func (r *specRenderer) renderRequiredIfClause() string {
+	return orList(
+		variable.Relationships[variable.RequiredIf](r.spec),
+		func(rel variable.RequiredIf) string {
+			return r.renderCondition(rel.RequiredIf, rel.Condition)
+		},
+	)
+}
+
+// renderCondition renders a description of a condition that the value of s
+// must satisfy. The description of the condition must complete the phrase
+// "when <variable>...".
+func (r *specRenderer) renderCondition(s variable.Spec, condition string) string {
+	if condition == "" {
+		return fmt.Sprintf(
+			"the value of %s satisfies an application-defined condition",
+			r.ren.linkToSpec(s),
+		)
+	}
+
+	return fmt.Sprintf(
+		"%s %s",
+		r.ren.linkToSpec(s),
+		condition,
+	)
+}
+
 // bestConstraint returns the constraint to use as the "primary"
 // requirement, favoring non-user-defined constraints.
 func (r *specRenderer) bestConstraint() (con variable.Constraint) {
@@ -0,0 +1,85 @@
+package markdown_test
+
+import (
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite/internal/mode/usage/markdown"
+	. "github.com/onsi/ginkgo/v2"
+)
+
+var _ = DescribeTable(
+	"address spec",
+	tableTest(
+		"spec/address",
+		WithoutExplanatoryText(),
+		WithoutIndex(),
+		WithoutUsageExamples(),
+	),
+	Entry(
+		"deprecated",
+		"deprecated.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				Address("LISTEN_ADDR", "the address to listen on").
+				Deprecated(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional",
+		"optional.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				Address("LISTEN_ADDR", "the address to listen on").
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required",
+		"required.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				Address("LISTEN_ADDR", "the address to listen on").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional with default value",
+		"with-default.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				Address("LISTEN_ADDR", "the address to listen on").
+				WithDefault(":8080").
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required with default value",
+		"with-default.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				Address("LISTEN_ADDR", "the address to listen on").
+				WithDefault(":8080").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"with default host",
+		"with-default-host.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				Address("LISTEN_ADDR", "the address to listen on").
+				WithDefaultHost("127.0.0.1").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"with default host set more than once",
+		"with-default-host.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				Address("LISTEN_ADDR", "the address to listen on").
+				WithDefaultHost("0.0.0.0").
+				WithDefaultHost("127.0.0.1").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+)
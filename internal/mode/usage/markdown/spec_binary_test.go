@@ -41,6 +41,28 @@ var _ = DescribeTable(
 				Required(ferrite.WithRegistry(reg))
 		},
 	),
+	Entry(
+		"with exact length",
+		"with-length.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				Binary("SESSION_KEY", "the key used to sign session cookies").
+				WithHexEncoding().
+				WithLength(32).
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"with length limits",
+		"with-length-limits.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				Binary("SESSION_KEY", "the key used to sign session cookies").
+				WithMinimumLength(16).
+				WithMaximumLength(64).
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
 	Entry(
 		"optional with default value",
 		"with-default.md",
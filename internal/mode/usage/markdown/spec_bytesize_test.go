@@ -0,0 +1,86 @@
+package markdown_test
+
+import (
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite/internal/mode/usage/markdown"
+	. "github.com/onsi/ginkgo/v2"
+)
+
+var _ = DescribeTable(
+	"byte-size spec",
+	tableTest(
+		"spec/bytesize",
+		WithoutExplanatoryText(),
+		WithoutIndex(),
+		WithoutUsageExamples(),
+	),
+	Entry(
+		"deprecated",
+		"deprecated.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				ByteSize("CACHE_SIZE", "the maximum size of the cache").
+				Deprecated(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional",
+		"optional.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				ByteSize("CACHE_SIZE", "the maximum size of the cache").
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required",
+		"required.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				ByteSize("CACHE_SIZE", "the maximum size of the cache").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional with default value",
+		"with-default.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				ByteSize("CACHE_SIZE", "the maximum size of the cache").
+				WithDefault(256 << 20).
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required with default value",
+		"with-default.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				ByteSize("CACHE_SIZE", "the maximum size of the cache").
+				WithDefault(256 << 20).
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"with limits",
+		"with-limits.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				ByteSize("CACHE_SIZE", "the maximum size of the cache").
+				WithMinimum(64 << 20).
+				WithMaximum(4 << 30).
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"with SI units",
+		"with-si-units.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				ByteSize("CACHE_SIZE", "the maximum size of the cache").
+				WithSIUnits().
+				WithDefault(500e6).
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+)
@@ -0,0 +1,76 @@
+package markdown_test
+
+import (
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite/internal/mode/usage/markdown"
+	. "github.com/onsi/ginkgo/v2"
+)
+
+var _ = DescribeTable(
+	"IP address spec",
+	tableTest(
+		"spec/ipaddr",
+		WithoutExplanatoryText(),
+		WithoutIndex(),
+		WithoutUsageExamples(),
+	),
+	Entry(
+		"deprecated",
+		"deprecated.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				IPAddr("BIND_ADDR", "the local address to bind to").
+				Deprecated(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional",
+		"optional.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				IPAddr("BIND_ADDR", "the local address to bind to").
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required",
+		"required.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				IPAddr("BIND_ADDR", "the local address to bind to").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional with default value",
+		"with-default.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				IPAddr("BIND_ADDR", "the local address to bind to").
+				WithDefault("0.0.0.0").
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required with default value",
+		"with-default.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				IPAddr("BIND_ADDR", "the local address to bind to").
+				WithDefault("0.0.0.0").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"with restrictions",
+		"with-restrictions.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				IPAddr("BIND_ADDR", "the local address to bind to").
+				WithIPv4Only().
+				WithoutLoopback().
+				WithinPrefix("10.0.0.0/8").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+)
@@ -0,0 +1,94 @@
+package markdown_test
+
+import (
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite/internal/mode/usage/markdown"
+	. "github.com/onsi/ginkgo/v2"
+)
+
+var _ = DescribeTable(
+	"map spec",
+	tableTest(
+		"spec/map",
+		WithoutExplanatoryText(),
+		WithoutIndex(),
+		WithoutUsageExamples(),
+	),
+	Entry(
+		"deprecated",
+		"deprecated.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				Map("LABELS", "labels to attach to metrics").
+				Deprecated(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional",
+		"optional.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				Map("LABELS", "labels to attach to metrics").
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required",
+		"required.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				Map("LABELS", "labels to attach to metrics").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional with default value",
+		"with-default.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				Map("LABELS", "labels to attach to metrics").
+				WithDefault(map[string]string{"env": "dev", "team": "platform"}).
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required with default value",
+		"with-default.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				Map("LABELS", "labels to attach to metrics").
+				WithDefault(map[string]string{"env": "dev", "team": "platform"}).
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"with element requirements",
+		"with-element-requirements.md",
+		func(reg ferrite.Registry) {
+			ferrite.MapOf[string, uint16](
+				"LISTEN_PORTS",
+				"ports to listen on, by protocol",
+				ferrite.
+					Enum("LISTEN_PORTS", "ports to listen on, by protocol").
+					WithMembers("http", "https", "grpc"),
+				ferrite.
+					Unsigned[uint16]("LISTEN_PORTS", "ports to listen on, by protocol").
+					WithMinimum(1024).
+					WithMaximum(49151),
+			).
+				WithPairSeparator(":").
+				WithEntrySeparator(";").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"with required keys",
+		"with-required-keys.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				Map("LABELS", "labels to attach to metrics").
+				WithRequiredKeys("env", "team").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+)
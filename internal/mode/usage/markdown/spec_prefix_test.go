@@ -0,0 +1,75 @@
+package markdown_test
+
+import (
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite/internal/mode/usage/markdown"
+	. "github.com/onsi/ginkgo/v2"
+)
+
+var _ = DescribeTable(
+	"prefix spec",
+	tableTest(
+		"spec/prefix",
+		WithoutExplanatoryText(),
+		WithoutIndex(),
+		WithoutUsageExamples(),
+	),
+	Entry(
+		"deprecated",
+		"deprecated.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				Prefix("TRUSTED_PROXIES", "the network range of trusted proxies").
+				Deprecated(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional",
+		"optional.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				Prefix("TRUSTED_PROXIES", "the network range of trusted proxies").
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required",
+		"required.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				Prefix("TRUSTED_PROXIES", "the network range of trusted proxies").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional with default value",
+		"with-default.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				Prefix("TRUSTED_PROXIES", "the network range of trusted proxies").
+				WithDefault("10.0.0.0/8").
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required with default value",
+		"with-default.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				Prefix("TRUSTED_PROXIES", "the network range of trusted proxies").
+				WithDefault("10.0.0.0/8").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"with restrictions",
+		"with-restrictions.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				Prefix("TRUSTED_PROXIES", "the network range of trusted proxies").
+				WithIPv4Only().
+				WithinPrefix("10.0.0.0/8").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+)
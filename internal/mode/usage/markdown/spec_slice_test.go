@@ -0,0 +1,97 @@
+package markdown_test
+
+import (
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite/internal/mode/usage/markdown"
+	. "github.com/onsi/ginkgo/v2"
+)
+
+var _ = DescribeTable(
+	"slice spec",
+	tableTest(
+		"spec/slice",
+		WithoutExplanatoryText(),
+		WithoutIndex(),
+		WithoutUsageExamples(),
+	),
+	Entry(
+		"deprecated",
+		"deprecated.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				Slice("CORS_ORIGINS", "allowed CORS origins").
+				Deprecated(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional",
+		"optional.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				Slice("CORS_ORIGINS", "allowed CORS origins").
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required",
+		"required.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				Slice("CORS_ORIGINS", "allowed CORS origins").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional with default value",
+		"with-default.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				Slice("CORS_ORIGINS", "allowed CORS origins").
+				WithDefault("example.org", "example.com").
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required with default value",
+		"with-default.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				Slice("CORS_ORIGINS", "allowed CORS origins").
+				WithDefault("example.org", "example.com").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"with element requirements",
+		"with-element-requirements.md",
+		func(reg ferrite.Registry) {
+			ferrite.SliceOf[uint16](
+				"LISTEN_PORTS",
+				"ports to listen on",
+				ferrite.
+					Unsigned[uint16]("LISTEN_PORTS", "ports to listen on").
+					WithMinimum(1024).
+					WithMaximum(49151),
+			).
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"with length limits",
+		"with-length-limits.md",
+		func(reg ferrite.Registry) {
+			ferrite.SliceOf[string](
+				"LOG_LEVELS",
+				"log levels to enable",
+				ferrite.
+					Enum("LOG_LEVELS", "log levels to enable").
+					WithMembers("debug", "info", "warn", "error"),
+			).
+				WithSeparator("|").
+				WithMinimumLength(1).
+				WithMaximumLength(2).
+				WithUniqueElements().
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+)
@@ -61,6 +61,48 @@ var _ = DescribeTable(
 				Required(ferrite.WithRegistry(reg))
 		},
 	),
+	Entry(
+		"with minimum length",
+		"with-minimum-length.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				String("API_TOKEN", "the token used to authenticate with the API").
+				WithMinimumLength(20).
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"with exact length",
+		"with-length.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				String("COUNTRY_CODE", "the default country").
+				WithLength(2).
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"with length limits and pattern",
+		"with-length-and-pattern.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				String("USERNAME", "the name of the administrator account").
+				WithMinimumLength(3).
+				WithMaximumLength(16).
+				WithPattern(`^\w+$`).
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"with pattern",
+		"with-pattern.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				String("ORDER_NUMBER", "the first order number to allocate").
+				WithPattern(`^ORD\d{6}$`).
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
 	Entry(
 		"optional with sensitive content",
 		"with-sensitive-optional.md",
@@ -0,0 +1,98 @@
+package markdown_test
+
+import (
+	"time"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite/internal/mode/usage/markdown"
+	. "github.com/onsi/ginkgo/v2"
+)
+
+var _ = DescribeTable(
+	"time spec",
+	tableTest(
+		"spec/time",
+		WithoutExplanatoryText(),
+		WithoutIndex(),
+		WithoutUsageExamples(),
+	),
+	Entry(
+		"deprecated",
+		"deprecated.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				Time("CUTOVER_TIME", "the time at which to switch to the new backend").
+				Deprecated(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional",
+		"optional.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				Time("CUTOVER_TIME", "the time at which to switch to the new backend").
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required",
+		"required.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				Time("CUTOVER_TIME", "the time at which to switch to the new backend").
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"optional with default value",
+		"with-default.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				Time("CUTOVER_TIME", "the time at which to switch to the new backend").
+				WithDefault(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)).
+				Optional(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"required with default value",
+		"with-default.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				Time("CUTOVER_TIME", "the time at which to switch to the new backend").
+				WithDefault(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)).
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"with limits",
+		"with-limits.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				Time("CUTOVER_TIME", "the time at which to switch to the new backend").
+				WithMinimum(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)).
+				WithMaximum(time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)).
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"with layout",
+		"with-layout.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				Time("CUTOVER_DATE", "the date on which to switch to the new backend").
+				WithLayout("2006-01-02").
+				WithMinimum(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)).
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+	Entry(
+		"with Unix seconds",
+		"with-unix-seconds.md",
+		func(reg ferrite.Registry) {
+			ferrite.
+				Time("CUTOVER_TIME", "the time at which to switch to the new backend").
+				WithUnixSeconds().
+				Required(ferrite.WithRegistry(reg))
+		},
+	),
+)
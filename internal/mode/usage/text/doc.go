@@ -0,0 +1,3 @@
+// Package text is a Ferrite mode that renders environment variable usage
+// instructions as plain text, suitable for display in a terminal.
+package text
@@ -0,0 +1,122 @@
+package text
+
+import (
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/dogmatiq/ferrite/internal/mode"
+	"github.com/dogmatiq/ferrite/internal/mode/usage/internal/markup"
+	"github.com/dogmatiq/ferrite/internal/mode/usage/markdown"
+	"github.com/dogmatiq/ferrite/internal/wordwrap"
+	"github.com/dogmatiq/iago/must"
+)
+
+const (
+	// defaultWidth is the width used when the terminal width is unknown.
+	defaultWidth = 80
+
+	// minWidth is the narrowest width that the output is wrapped to.
+	minWidth = 40
+
+	// indent is the indentation of the text that describes each variable.
+	indent = "    "
+)
+
+// Run generates environment variable usage instructions in plain text.
+func Run(cfg mode.Config, options ...Option) {
+	r := renderer{
+		Width: terminalWidth(),
+	}
+
+	for _, opt := range options {
+		opt(&r)
+	}
+
+	var md strings.Builder
+	markdown.RenderSpecs(&md, cfg.Registries.Variables())
+
+	for _, b := range markup.Parse(md.String()) {
+		r.renderBlock(b)
+	}
+
+	must.WriteString(cfg.Out, r.Output.String())
+	cfg.Exit(0)
+}
+
+// Option is a function that changes the behavior of a renderer.
+type Option func(*renderer)
+
+// WithWidth sets the width at which the output is wrapped, instead of using
+// the width of the terminal.
+func WithWidth(w int) Option {
+	return func(r *renderer) {
+		r.Width = w
+	}
+}
+
+// terminalWidth returns the width of the terminal, as described by the COLUMNS
+// environment variable.
+func terminalWidth() int {
+	if n, err := strconv.Atoi(os.Getenv("COLUMNS")); err == nil && n > 0 {
+		return n
+	}
+	return defaultWidth
+}
+
+type renderer struct {
+	Width  int
+	Output strings.Builder
+}
+
+func (r *renderer) renderBlock(b markup.Block) {
+	switch b.Kind {
+	case markup.VariableHeading:
+		if r.Output.Len() != 0 {
+			r.line("")
+		}
+		r.line(markup.PlainText(b.Text))
+		return
+
+	case markup.Description:
+		r.wrap(indent, indent, b.Text)
+		return
+	}
+
+	r.line("")
+
+	switch b.Kind {
+	case markup.Heading:
+		r.wrap(indent, indent, b.Text+":")
+	case markup.Paragraph:
+		r.wrap(indent, indent, b.Text)
+	case markup.ListItem:
+		r.wrap(indent+"- ", indent+"  ", b.Text)
+	case markup.Code:
+		for _, l := range b.Lines {
+			r.line(indent + "  " + l)
+		}
+	}
+}
+
+// wrap renders text, wrapped to the width of the output. first is the prefix
+// used for the first line, and rest is the prefix used for subsequent lines.
+func (r *renderer) wrap(first, rest, text string) {
+	width := r.Width - len(first)
+	if width < minWidth {
+		width = minWidth
+	}
+
+	for i, l := range wordwrap.Wrap(markup.PlainText(text), width) {
+		if i == 0 {
+			r.line(first + l)
+		} else {
+			r.line(rest + l)
+		}
+	}
+}
+
+func (r *renderer) line(text string) {
+	r.Output.WriteString(strings.TrimRight(text, " "))
+	r.Output.WriteByte('\n')
+}
@@ -43,6 +43,14 @@ func (r *schemaRenderer) VisitBinary(s variable.Binary) {
 	r.Output.WriteByte('>')
 }
 
+func (r *schemaRenderer) VisitMap(s variable.Map) {
+	r.renderElement(s.Key())
+	r.Output.WriteString(strings.TrimSpace(s.PairSeparator()))
+	r.renderElement(s.Value())
+	r.Output.WriteString(strings.TrimSpace(s.EntrySeparator()))
+	r.Output.WriteString(" ...")
+}
+
 func (r *schemaRenderer) VisitNumeric(s variable.Numeric) {
 	min, hasMin := s.Min()
 	max, hasMax := s.Max()
@@ -85,6 +93,12 @@ func (r *schemaRenderer) VisitSet(s variable.Set) {
 	}
 }
 
+func (r *schemaRenderer) VisitSlice(s variable.Slice) {
+	r.renderElement(s.Element())
+	r.Output.WriteString(strings.TrimSpace(s.Separator()))
+	r.Output.WriteString(" ...")
+}
+
 func (r *schemaRenderer) VisitString(s variable.String) {
 	fmt.Fprintf(r.Output, "<%s>", s.Type().Kind())
 }
@@ -111,3 +125,18 @@ again:
 		r.Output.WriteString("<string>")
 	}
 }
+
+// renderElement renders the schema of an element of a composite value, such as
+// a slice or map.
+func (r *schemaRenderer) renderElement(s variable.Spec) {
+	elem := &strings.Builder{}
+	s.Schema().AcceptVisitor(&schemaRenderer{
+		Output: elem,
+	})
+
+	if strings.Contains(elem.String(), " ") {
+		fmt.Fprintf(r.Output, "(%s)", elem)
+	} else {
+		r.Output.WriteString(elem.String())
+	}
+}
@@ -1,7 +1,9 @@
 package validate
 
 import (
+	"errors"
 	"fmt"
+	"io/fs"
 	"strings"
 
 	"github.com/dogmatiq/ferrite/internal/mode/internal/render"
@@ -25,6 +27,24 @@ func value(v variable.Any) string {
 		out.WriteString("set to ")
 		out.WriteString(render.Value(s, lit))
 
+		if a := v.Alias(); a != "" {
+			out.WriteString(" via deprecated alias ")
+			out.WriteString(a)
+		}
+
+		if v.Source() == variable.SourceFile {
+			out.WriteString(" from ")
+			out.WriteString(v.File())
+		} else if o := v.Origin(); o != "" {
+			out.WriteString(" from ")
+			out.WriteString(o)
+		}
+
+		if f := v.Fallback(); f != "" {
+			out.WriteString(", value taken from deprecated ")
+			out.WriteString(f)
+		}
+
 		if message != "" {
 			out.WriteString(", ")
 			out.WriteString(message)
@@ -38,12 +58,31 @@ func value(v variable.Any) string {
 		if s.IsRequired() {
 			return fmt.Sprintf("%s undefined", iconError)
 		}
+		if v.Error() != nil {
+			return fmt.Sprintf("%s undefined, %s", iconError, requiredIf(s))
+		}
 		return fmt.Sprintf("%s undefined", iconNeutral)
 
 	case variable.SourceDefault:
 		return fmt.Sprintf("%s using default value", iconOK)
 
 	default:
+		if err, ok := v.Error().(variable.FileError); ok {
+			cause := err.Unwrap()
+
+			var pathErr *fs.PathError
+			if errors.As(cause, &pathErr) {
+				cause = pathErr.Err
+			}
+
+			return fmt.Sprintf(
+				"%s unable to read %s, %s",
+				iconError,
+				err.Path(),
+				cause,
+			)
+		}
+
 		if err, ok := v.Error().(variable.ValueError); ok {
 			return renderExplicit(
 				iconError,
@@ -53,7 +92,7 @@ func value(v variable.Any) string {
 		}
 
 		icon := iconOK
-		if s.IsDeprecated() {
+		if s.IsDeprecated() || v.Alias() != "" {
 			icon = iconWarn
 		}
 
@@ -70,3 +109,25 @@ func value(v variable.Any) string {
 		return renderExplicit(icon, value.Verbatim(), message)
 	}
 }
+
+// requiredIf renders a description of the conditions under which an otherwise
+// optional variable is required.
+func requiredIf(s variable.Spec) string {
+	var conditions []string
+
+	for _, rel := range variable.Relationships[variable.RequiredIf](s) {
+		if rel.Condition == "" {
+			conditions = append(
+				conditions,
+				"required due to the value of "+rel.RequiredIf.Name(),
+			)
+		} else {
+			conditions = append(
+				conditions,
+				"required when "+rel.RequiredIf.Name()+" "+rel.Condition,
+			)
+		}
+	}
+
+	return strings.Join(conditions, " or ")
+}
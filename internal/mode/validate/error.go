@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/dogmatiq/ferrite/internal/mode/internal/render"
 	"github.com/dogmatiq/ferrite/internal/variable"
 )
 
@@ -11,16 +12,57 @@ func renderError(s variable.Spec, err variable.ValueError) string {
 	out := &strings.Builder{}
 	err.AcceptVisitor(&errorRenderer{
 		Output: out,
+		Spec:   s,
 		Schema: s.Schema(),
-		Error:  err,
+		Cause:  err.Unwrap(),
+	})
+	return out.String()
+}
+
+// renderCause renders the cause of a nested error, such as an error with a
+// specific element of a slice.
+//
+// s is the spec of the variable that contains the nested value, and schema is
+// the schema of the nested value itself.
+func renderCause(
+	s variable.Spec,
+	schema variable.Schema,
+	err interface {
+		AcceptCauseVisitor(variable.ValueErrorVisitor)
+		Unwrap() error
+	},
+) string {
+	out := &strings.Builder{}
+	err.AcceptCauseVisitor(&errorRenderer{
+		Output: out,
+		Spec:   s,
+		Schema: schema,
+		Cause:  err.Unwrap(),
 	})
 	return out.String()
 }
 
 type errorRenderer struct {
 	Output *strings.Builder
+	Spec   variable.Spec
 	Schema variable.Schema
-	Error  variable.ValueError
+	Cause  error
+}
+
+// value renders a value nested within the variable, such as an element of a
+// slice, using the spec that describes it.
+//
+// The value is redacted if the variable itself is sensitive, regardless of
+// whether the nested spec is sensitive.
+func (r *errorRenderer) value(s variable.Spec, v variable.Literal) string {
+	if r.Spec.IsSensitive() {
+		return render.Value(r.Spec, v)
+	}
+	return render.Value(s, v)
+}
+
+func (r *errorRenderer) VisitConstraintViolation(err variable.ConstraintViolation) {
+	r.Output.WriteString(err.Error())
 }
 
 func (r *errorRenderer) VisitGenericError(err error) {
@@ -28,7 +70,61 @@ func (r *errorRenderer) VisitGenericError(err error) {
 }
 
 func (r *errorRenderer) VisitBinary(s variable.Binary) {
-	r.Output.WriteString(r.Error.Unwrap().Error())
+	r.Output.WriteString(r.Cause.Error())
+}
+
+func (r *errorRenderer) VisitMap(s variable.Map) {
+	r.Output.WriteString(r.Cause.Error())
+}
+
+func (r *errorRenderer) VisitEntryError(err variable.EntryError) {
+	entry := err.Entry.Quote()
+	if r.Spec.IsSensitive() {
+		entry = render.Value(r.Spec, err.Entry)
+	}
+
+	fmt.Fprintf(
+		r.Output,
+		"entry %d (%s) is invalid, expected a key and value separated by %s",
+		err.Index+1,
+		entry,
+		variable.Literal{String: err.Map.PairSeparator()}.Quote(),
+	)
+}
+
+func (r *errorRenderer) VisitKeyError(err variable.KeyError) {
+	fmt.Fprintf(
+		r.Output,
+		"key %s is invalid, %s",
+		r.value(err.Map.Key(), err.Key),
+		renderCause(r.Spec, err.Map.Key().Schema(), err),
+	)
+}
+
+func (r *errorRenderer) VisitEntryValueError(err variable.EntryValueError) {
+	fmt.Fprintf(
+		r.Output,
+		"value of key %s (%s) is invalid, %s",
+		r.value(err.Map.Key(), err.Key),
+		r.value(err.Map.Value(), err.Value),
+		renderCause(r.Spec, err.Map.Value().Schema(), err),
+	)
+}
+
+func (r *errorRenderer) VisitDuplicateKeyError(err variable.DuplicateKeyError) {
+	fmt.Fprintf(
+		r.Output,
+		"key %s is specified more than once, expected unique keys",
+		r.value(err.Map.Key(), err.Key),
+	)
+}
+
+func (r *errorRenderer) VisitMissingKeyError(err variable.MissingKeyError) {
+	fmt.Fprintf(
+		r.Output,
+		"missing required key %s",
+		r.value(err.Map.Key(), err.Key),
+	)
 }
 
 func (r *errorRenderer) VisitNumeric(s variable.Numeric) {
@@ -63,15 +159,39 @@ func (r *errorRenderer) VisitMaxError(err variable.MaxError) {
 }
 
 func (r *errorRenderer) VisitSet(s variable.Set) {
-	r.Output.WriteString(r.Error.Unwrap().Error())
+	r.Output.WriteString(r.Cause.Error())
 }
 
 func (r *errorRenderer) VisitSetMembershipError(err variable.SetMembershipError) {
 	r.Output.WriteString(err.Error())
 }
 
+func (r *errorRenderer) VisitSlice(s variable.Slice) {
+	r.Output.WriteString(r.Cause.Error())
+}
+
+func (r *errorRenderer) VisitElementError(err variable.ElementError) {
+	fmt.Fprintf(
+		r.Output,
+		"element %d (%s) is invalid, %s",
+		err.Index+1,
+		r.value(err.Slice.Element(), err.Element),
+		renderCause(r.Spec, err.Slice.Element().Schema(), err),
+	)
+}
+
+func (r *errorRenderer) VisitDuplicateElementError(err variable.DuplicateElementError) {
+	fmt.Fprintf(
+		r.Output,
+		"element %d (%s) is a duplicate of element %d, expected unique elements",
+		err.Index+1,
+		r.value(err.Slice.Element(), err.Element),
+		err.FirstIndex+1,
+	)
+}
+
 func (r *errorRenderer) VisitString(s variable.String) {
-	r.Output.WriteString(r.Error.Unwrap().Error())
+	r.Output.WriteString(r.Cause.Error())
 }
 
 func (r *errorRenderer) VisitMinLengthError(err variable.MinLengthError) {
@@ -82,6 +202,10 @@ func (r *errorRenderer) VisitMaxLengthError(err variable.MaxLengthError) {
 	r.Output.WriteString(err.Error())
 }
 
+func (r *errorRenderer) VisitPatternError(err variable.PatternError) {
+	r.Output.WriteString(err.Error())
+}
+
 func (r *errorRenderer) VisitOther(s variable.Other) {
-	r.Output.WriteString(r.Error.Unwrap().Error())
+	r.Output.WriteString(r.Cause.Error())
 }
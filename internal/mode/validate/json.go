@@ -0,0 +1,326 @@
+package validate
+
+import (
+	"encoding/json"
+
+	"github.com/dogmatiq/ferrite/internal/mode"
+	"github.com/dogmatiq/ferrite/internal/variable"
+)
+
+// RunJSON validates the variables in the given registry and renders the result
+// as a sequence of JSON documents, one per variable, to cfg.Out. An additional
+// document is rendered for each violated group constraint.
+//
+// It exits with a non-zero exit code if any of the variables are invalid, or if
+// any group constraint is violated.
+func RunJSON(cfg mode.Config) {
+	valid := true
+	enc := json.NewEncoder(cfg.Out)
+
+	for _, v := range cfg.Registries.Variables() {
+		if attentionNeeded(v) == attentionError {
+			valid = false
+		}
+
+		if err := enc.Encode(jsonVariableOf(v)); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, g := range cfg.Registries.Groups() {
+		if err := g.Check(nil); err != nil {
+			valid = false
+
+			if err := enc.Encode(jsonGroupOf(g, err)); err != nil {
+				panic(err)
+			}
+		}
+	}
+
+	if !valid {
+		cfg.Exit(1)
+	} else {
+		cfg.Exit(0)
+	}
+}
+
+// jsonVariable is the JSON representation of a variable and its value.
+type jsonVariable struct {
+	Name         string     `json:"name"`
+	Registry     string     `json:"registry,omitempty"`
+	Availability string     `json:"availability"`
+	Source       string     `json:"source"`
+	Origin       string     `json:"origin,omitempty"`
+	Alias        string     `json:"alias,omitempty"`
+	Fallback     string     `json:"fallback,omitempty"`
+	File         string     `json:"file,omitempty"`
+	IsSensitive  bool       `json:"sensitive"`
+	Value        *string    `json:"value,omitempty"`
+	Canonical    *string    `json:"canonical,omitempty"`
+	Error        *jsonError `json:"error,omitempty"`
+}
+
+// jsonGroup is the JSON representation of a violated constraint on a group of
+// variables.
+type jsonGroup struct {
+	Group     string     `json:"group"`
+	Variables []string   `json:"variables"`
+	Error     *jsonError `json:"error"`
+}
+
+// jsonGroupOf returns the JSON representation of g, which has violated its
+// constraint as described by err.
+func jsonGroupOf(g *variable.Group, err error) jsonGroup {
+	doc := jsonGroup{
+		Group: groupConstraintNames[g.Constraint],
+		Error: &jsonError{
+			Type:    "group",
+			Message: err.Error(),
+		},
+	}
+
+	for _, s := range g.Specs() {
+		doc.Variables = append(doc.Variables, s.Name())
+	}
+
+	return doc
+}
+
+var groupConstraintNames = map[variable.GroupConstraint]string{
+	variable.ExactlyOne: "exactly_one_of",
+	variable.AtMostOne:  "at_most_one_of",
+	variable.AtLeastOne: "at_least_one_of",
+	variable.AllOrNone:  "all_or_none",
+}
+
+// jsonError is the JSON representation of a problem with a variable's value.
+type jsonError struct {
+	Type       string     `json:"type"`
+	Message    string     `json:"message,omitempty"`
+	Constraint string     `json:"constraint,omitempty"`
+	Min        *string    `json:"min,omitempty"`
+	Max        *string    `json:"max,omitempty"`
+	MinLength  *int       `json:"min_length,omitempty"`
+	MaxLength  *int       `json:"max_length,omitempty"`
+	Members    []string   `json:"members,omitempty"`
+	Pattern    string     `json:"pattern,omitempty"`
+	Index      *int       `json:"index,omitempty"`
+	FirstIndex *int       `json:"first_index,omitempty"`
+	Key        *string    `json:"key,omitempty"`
+	Cause      *jsonError `json:"cause,omitempty"`
+}
+
+// jsonVariableOf returns the JSON representation of v.
+func jsonVariableOf(v variable.RegisteredVariable) jsonVariable {
+	s := v.Spec()
+
+	doc := jsonVariable{
+		Name:         s.Name(),
+		Registry:     v.Registry.Key,
+		Availability: availabilityNames[v.Availability()],
+		Source:       sourceNames[v.Source()],
+		Origin:       v.Origin(),
+		Alias:        v.Alias(),
+		Fallback:     v.Fallback(),
+		File:         v.File(),
+		IsSensitive:  s.IsSensitive(),
+	}
+
+	reveal := func(lit variable.Literal) *string {
+		if s.IsSensitive() {
+			return nil
+		}
+		return &lit.String
+	}
+
+	switch err := v.Error().(type) {
+	case nil:
+		switch v.Source() {
+		case variable.SourceNone:
+		case variable.SourceDefault:
+			// There is no verbatim value when the default value is used.
+			doc.Canonical = reveal(v.Value().Canonical())
+		default:
+			value := v.Value()
+			doc.Value = reveal(value.Verbatim())
+			doc.Canonical = reveal(value.Canonical())
+		}
+
+	case variable.ValueError:
+		doc.Value = reveal(err.Literal())
+		doc.Error = &jsonError{
+			Message: renderError(s, err),
+		}
+
+		err.AcceptVisitor(&jsonErrorBuilder{
+			Error: doc.Error,
+			Spec:  s,
+		})
+
+	case variable.FileError:
+		doc.Error = &jsonError{
+			Type:    "file",
+			Message: err.Error(),
+		}
+
+	default:
+		doc.Error = &jsonError{
+			Type:    "undefined",
+			Message: err.Error(),
+		}
+	}
+
+	return doc
+}
+
+var availabilityNames = map[variable.Availability]string{
+	variable.AvailabilityNone:    "none",
+	variable.AvailabilityInvalid: "invalid",
+	variable.AvailabilityIgnored: "ignored",
+	variable.AvailabilityOK:      "ok",
+}
+
+var sourceNames = map[variable.Source]string{
+	variable.SourceNone:        "none",
+	variable.SourceDefault:     "default",
+	variable.SourceEnvironment: "environment",
+	variable.SourceFile:        "file",
+}
+
+// jsonErrorBuilder populates a jsonError based on the cause of a value error.
+type jsonErrorBuilder struct {
+	Error *jsonError
+	Spec  variable.Spec
+}
+
+// nested returns a builder for the cause of a nested error, such as an error
+// with a specific element of a slice.
+//
+// message is the rendered description of the cause.
+func (b *jsonErrorBuilder) nested(message string) *jsonErrorBuilder {
+	b.Error.Cause = &jsonError{
+		Message: message,
+	}
+
+	return &jsonErrorBuilder{
+		Error: b.Error.Cause,
+		Spec:  b.Spec,
+	}
+}
+
+func (b *jsonErrorBuilder) reveal(lit variable.Literal) *string {
+	if b.Spec.IsSensitive() {
+		return nil
+	}
+	return &lit.String
+}
+
+func (b *jsonErrorBuilder) VisitGenericError(error) {
+	b.Error.Type = "invalid"
+}
+
+func (b *jsonErrorBuilder) VisitConstraintViolation(err variable.ConstraintViolation) {
+	b.Error.Type = "constraint"
+	b.Error.Constraint = err.Constraint.Description()
+}
+
+func (b *jsonErrorBuilder) VisitEntryError(err variable.EntryError) {
+	b.Error.Type = "entry"
+	b.Error.Index = &err.Index
+}
+
+func (b *jsonErrorBuilder) VisitKeyError(err variable.KeyError) {
+	b.Error.Type = "key"
+	b.Error.Key = b.reveal(err.Key)
+	err.AcceptCauseVisitor(
+		b.nested(renderCause(b.Spec, err.Map.Key().Schema(), err)),
+	)
+}
+
+func (b *jsonErrorBuilder) VisitEntryValueError(err variable.EntryValueError) {
+	b.Error.Type = "entry_value"
+	b.Error.Key = b.reveal(err.Key)
+	err.AcceptCauseVisitor(
+		b.nested(renderCause(b.Spec, err.Map.Value().Schema(), err)),
+	)
+}
+
+func (b *jsonErrorBuilder) VisitDuplicateKeyError(err variable.DuplicateKeyError) {
+	b.Error.Type = "duplicate_key"
+	b.Error.Key = b.reveal(err.Key)
+}
+
+func (b *jsonErrorBuilder) VisitMissingKeyError(err variable.MissingKeyError) {
+	b.Error.Type = "missing_key"
+	b.Error.Key = &err.Key.String
+}
+
+func (b *jsonErrorBuilder) VisitMinError(err variable.MinError) {
+	b.Error.Type = "min"
+	b.setLimits(err.Numeric)
+}
+
+func (b *jsonErrorBuilder) VisitMaxError(err variable.MaxError) {
+	b.Error.Type = "max"
+	b.setLimits(err.Numeric)
+}
+
+func (b *jsonErrorBuilder) setLimits(s variable.Numeric) {
+	if min, ok := s.Min(); ok {
+		b.Error.Min = &min.String
+	}
+
+	if max, ok := s.Max(); ok {
+		b.Error.Max = &max.String
+	}
+}
+
+func (b *jsonErrorBuilder) VisitSetMembershipError(err variable.SetMembershipError) {
+	b.Error.Type = "set_membership"
+
+	for _, m := range err.Set.Literals() {
+		b.Error.Members = append(b.Error.Members, m.String)
+	}
+}
+
+func (b *jsonErrorBuilder) VisitElementError(err variable.ElementError) {
+	b.Error.Type = "element"
+	b.Error.Index = &err.Index
+	err.AcceptCauseVisitor(
+		b.nested(renderCause(b.Spec, err.Slice.Element().Schema(), err)),
+	)
+}
+
+func (b *jsonErrorBuilder) VisitDuplicateElementError(err variable.DuplicateElementError) {
+	b.Error.Type = "duplicate_element"
+	b.Error.Index = &err.Index
+	b.Error.FirstIndex = &err.FirstIndex
+}
+
+func (b *jsonErrorBuilder) VisitMinLengthError(err variable.MinLengthError) {
+	b.Error.Type = "min_length"
+	b.setLengthLimits(err.ViolatedSchema)
+}
+
+func (b *jsonErrorBuilder) VisitMaxLengthError(err variable.MaxLengthError) {
+	b.Error.Type = "max_length"
+	b.setLengthLimits(err.ViolatedSchema)
+}
+
+func (b *jsonErrorBuilder) setLengthLimits(s variable.LengthLimited) {
+	if min, ok := s.MinLength(); ok {
+		b.Error.MinLength = &min
+	}
+
+	if max, ok := s.MaxLength(); ok {
+		b.Error.MaxLength = &max
+	}
+}
+
+func (b *jsonErrorBuilder) VisitPatternError(err variable.PatternError) {
+	b.Error.Type = "pattern"
+
+	if re, ok := err.ViolatedSchema.Pattern(); ok {
+		b.Error.Pattern = re.String()
+	}
+}
@@ -1,6 +1,7 @@
 package validate
 
 import (
+	"fmt"
 	"io"
 
 	"github.com/dogmatiq/ferrite/internal/mode"
@@ -35,6 +36,15 @@ func Run(cfg mode.Config) {
 		}
 	}
 
+	var groupErrors []error
+	for _, g := range cfg.Registries.Groups() {
+		if err := g.Check(nil); err != nil {
+			groupErrors = append(groupErrors, err)
+			show = true
+			valid = false
+		}
+	}
+
 	if show {
 		if _, err := io.WriteString(cfg.Err, "Environment Variables:\n\n"); err != nil {
 			panic(err)
@@ -44,6 +54,18 @@ func Run(cfg mode.Config) {
 			panic(err)
 		}
 
+		if len(groupErrors) != 0 {
+			if _, err := io.WriteString(cfg.Err, "\n"); err != nil {
+				panic(err)
+			}
+
+			for _, err := range groupErrors {
+				if _, err := fmt.Fprintf(cfg.Err, " %s %s\n", iconError, err); err != nil {
+					panic(err)
+				}
+			}
+		}
+
 		if _, err := io.WriteString(cfg.Err, "\n"); err != nil {
 			panic(err)
 		}
@@ -79,14 +101,22 @@ func attentionNeeded(v variable.Any) attentionLevel {
 			return attentionError
 		}
 
-		if _, ok := err.(variable.ValueError); ok {
+		switch err.(type) {
+		case variable.ValueError, variable.FileError:
 			return attentionWarning
 		}
 	}
 
-	if s.IsDeprecated() && v.Source() == variable.SourceEnvironment {
+	if v.Alias() != "" {
 		return attentionWarning
 	}
 
+	if s.IsDeprecated() {
+		switch v.Source() {
+		case variable.SourceEnvironment, variable.SourceFile:
+			return attentionWarning
+		}
+	}
+
 	return attentionNone
 }
@@ -24,6 +24,21 @@ type ConstraintError interface {
 	error
 }
 
+// ConstraintViolation is an error that indicates a value does not satisfy one
+// of a specification's constraints.
+type ConstraintViolation struct {
+	Constraint Constraint
+	Cause      ConstraintError
+}
+
+func (e ConstraintViolation) Error() string {
+	return e.Cause.Error()
+}
+
+func (e ConstraintViolation) Unwrap() error {
+	return e.Cause
+}
+
 // constraint is a function that implements the Constraint interface.
 type constraint[T any] struct {
 	desc  string
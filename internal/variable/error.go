@@ -59,17 +59,22 @@ func explainLengthError(s LengthLimited) string {
 	min, hasMin := s.MinLength()
 	max, hasMax := s.MaxLength()
 
+	unit := "bytes"
+	if _, ok := s.(Slice); ok {
+		unit = "elements"
+	}
+
 	if !hasMin {
-		return fmt.Sprintf("expected length to be %d bytes or fewer", max)
+		return fmt.Sprintf("expected length to be %d %s or fewer", max, unit)
 	}
 
 	if !hasMax {
-		return fmt.Sprintf("expected length to be %d bytes or more", max)
+		return fmt.Sprintf("expected length to be %d %s or more", min, unit)
 	}
 
 	if min == max {
-		return fmt.Sprintf("expected length to be exactly %d bytes", min)
+		return fmt.Sprintf("expected length to be exactly %d %s", min, unit)
 	}
 
-	return fmt.Sprintf("expected length to be between %d and %d bytes", min, max)
+	return fmt.Sprintf("expected length to be between %d and %d %s", min, max, unit)
 }
@@ -0,0 +1,210 @@
+package variable
+
+import (
+	"fmt"
+	"strings"
+)
+
+// GroupConstraint is an enumeration of the constraints that may be placed on
+// which of the variable sets in a group are defined.
+type GroupConstraint int
+
+const (
+	// ExactlyOne indicates that exactly one of the variable sets in a group
+	// must be defined.
+	ExactlyOne GroupConstraint = iota
+
+	// AtMostOne indicates that no more than one of the variable sets in a
+	// group may be defined.
+	AtMostOne
+
+	// AtLeastOne indicates that one or more of the variable sets in a group
+	// must be defined.
+	AtLeastOne
+
+	// AllOrNone indicates that either all of the variable sets in a group must
+	// be defined, or none of them may be defined.
+	AllOrNone
+)
+
+// Group is a group of variable sets that is subject to a [GroupConstraint].
+//
+// Each member of the group is a set of one or more variables. A member is
+// considered to be defined if any of its variables has a value, including a
+// default value, unless that variable is being ignored.
+type Group struct {
+	Constraint GroupConstraint
+	Members    [][]Any
+}
+
+// NewGroup returns a new group and establishes the relationships between its
+// members.
+//
+// It panics if there are fewer than two members.
+func NewGroup(c GroupConstraint, members ...[]Any) *Group {
+	if len(members) < 2 {
+		panic("a group must contain at least two variable sets")
+	}
+
+	g := &Group{c, members}
+
+	for i, m := range members {
+		for j, o := range members {
+			if i == j {
+				continue
+			}
+
+			for _, sub := range m {
+				for _, obj := range o {
+					EstablishRelationships(
+						groupRelationship(c, sub.Spec(), obj.Spec(), g),
+					)
+				}
+			}
+		}
+	}
+
+	return g
+}
+
+// Specs returns the specifications of the variables in all of the group's
+// members, in order.
+func (g *Group) Specs() []Spec {
+	var specs []Spec
+
+	for _, m := range g.Members {
+		for _, v := range m {
+			specs = append(specs, v.Spec())
+		}
+	}
+
+	return specs
+}
+
+// Check returns an error if the group's constraint is not satisfied by the
+// values of its variables as they are resolved within s.
+//
+// If s is nil, the variables' own values are used.
+func (g *Group) Check(s *Scope) error {
+	members := make([][]Any, len(g.Members))
+	for i, m := range g.Members {
+		members[i] = make([]Any, len(m))
+		for j, v := range m {
+			members[i][j] = In(v, s)
+		}
+	}
+
+	return CheckGroup(g.Constraint, members...)
+}
+
+// CheckGroup returns an error if the constraint c is not satisfied by the
+// given members.
+func CheckGroup(c GroupConstraint, members ...[]Any) error {
+	var all, defined, undefined []string
+
+	for _, m := range members {
+		name := memberName(m)
+		all = append(all, name)
+
+		if isMemberDefined(m) {
+			defined = append(defined, name)
+		} else {
+			undefined = append(undefined, name)
+		}
+	}
+
+	switch c {
+	case ExactlyOne:
+		if len(defined) > 1 {
+			return fmt.Errorf(
+				"%s are defined, define exactly one of %s",
+				joinNames(defined, "and"),
+				joinNames(all, "or"),
+			)
+		}
+
+		if len(defined) == 0 {
+			return fmt.Errorf(
+				"%s are undefined, define exactly one of them",
+				joinNames(undefined, "and"),
+			)
+		}
+
+	case AtMostOne:
+		if len(defined) > 1 {
+			return fmt.Errorf(
+				"%s are defined, define no more than one of %s",
+				joinNames(defined, "and"),
+				joinNames(all, "or"),
+			)
+		}
+
+	case AtLeastOne:
+		if len(defined) == 0 {
+			return fmt.Errorf(
+				"%s are undefined, define at least one of them",
+				joinNames(undefined, "and"),
+			)
+		}
+
+	case AllOrNone:
+		if len(defined) != 0 && len(undefined) != 0 {
+			every, none := "all", "none"
+			if len(members) == 2 {
+				every, none = "both", "neither"
+			}
+
+			return fmt.Errorf(
+				"%s %s defined but %s %s not, define %s or %s",
+				joinNames(defined, "and"),
+				pluralize(defined, "is", "are"),
+				joinNames(undefined, "and"),
+				pluralize(undefined, "is", "are"),
+				every,
+				none,
+			)
+		}
+	}
+
+	return nil
+}
+
+// isMemberDefined returns true if any of the variables in a group member has a
+// value that is not being ignored.
+func isMemberDefined(vars []Any) bool {
+	for _, v := range vars {
+		if v.Source() != SourceNone && v.Availability() != AvailabilityIgnored {
+			return true
+		}
+	}
+
+	return false
+}
+
+// memberName returns a human-readable name for a group member.
+func memberName(vars []Any) string {
+	names := make([]string, len(vars))
+	for i, v := range vars {
+		names[i] = v.Spec().Name()
+	}
+	return strings.Join(names, "/")
+}
+
+// joinNames returns a human-readable list of names, using conj as the
+// conjunction between the last two names.
+func joinNames(names []string, conj string) string {
+	if len(names) == 1 {
+		return names[0]
+	}
+
+	return strings.Join(names[:len(names)-1], ", ") + " " + conj + " " + names[len(names)-1]
+}
+
+// pluralize returns singular if there is exactly one name, otherwise it
+// returns plural.
+func pluralize(names []string, singular, plural string) string {
+	if len(names) == 1 {
+		return singular
+	}
+	return plural
+}
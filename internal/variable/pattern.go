@@ -0,0 +1,115 @@
+package variable
+
+import (
+	"regexp"
+	"regexp/syntax"
+	"strings"
+	"unicode"
+)
+
+// patternExample returns a string that matches re, if one can be derived.
+func patternExample(re *regexp.Regexp) (string, bool) {
+	expr, err := syntax.Parse(re.String(), syntax.Perl)
+	if err != nil {
+		return "", false
+	}
+
+	var w strings.Builder
+	if !writePatternExample(&w, expr.Simplify()) {
+		return "", false
+	}
+
+	eg := w.String()
+	if eg == "" || !re.MatchString(eg) {
+		return "", false
+	}
+
+	return eg, true
+}
+
+// writePatternExample writes a string that matches expr to w. It returns false
+// if no such string can be derived.
+func writePatternExample(w *strings.Builder, expr *syntax.Regexp) bool {
+	switch expr.Op {
+	case syntax.OpNoMatch:
+		return false
+
+	case syntax.OpLiteral:
+		w.WriteString(string(expr.Rune))
+
+	case syntax.OpCharClass:
+		r, ok := charClassExample(expr.Rune)
+		if !ok {
+			return false
+		}
+		w.WriteRune(r)
+
+	case syntax.OpAnyChar, syntax.OpAnyCharNotNL:
+		w.WriteRune('x')
+
+	case syntax.OpCapture:
+		return writePatternExample(w, expr.Sub[0])
+
+	case syntax.OpStar, syntax.OpPlus:
+		// Prefer a single repetition over an empty match, as an empty value
+		// is not distinguishable from an undefined variable.
+		return writePatternExample(w, expr.Sub[0])
+
+	case syntax.OpRepeat:
+		n := expr.Min
+		if n == 0 && expr.Max != 0 {
+			n = 1
+		}
+
+		for i := 0; i < n; i++ {
+			if !writePatternExample(w, expr.Sub[0]) {
+				return false
+			}
+		}
+
+	case syntax.OpConcat:
+		for _, sub := range expr.Sub {
+			if !writePatternExample(w, sub) {
+				return false
+			}
+		}
+
+	case syntax.OpAlternate:
+		for _, sub := range expr.Sub {
+			var alt strings.Builder
+			if writePatternExample(&alt, sub) {
+				w.WriteString(alt.String())
+				return true
+			}
+		}
+		return false
+	}
+
+	// All other operators, such as OpQuest and the anchors, can be satisfied by
+	// an empty string.
+	return true
+}
+
+// charClassExample returns a representative rune from a character class,
+// preferring alphanumeric characters.
+func charClassExample(ranges []rune) (rune, bool) {
+	if len(ranges) == 0 {
+		return 0, false
+	}
+
+	for _, preferred := range []*unicode.RangeTable{
+		unicode.Lower,
+		unicode.Digit,
+		unicode.Upper,
+	} {
+		for i := 0; i < len(ranges); i += 2 {
+			for r := ranges[i]; r <= ranges[i+1] && r <= unicode.MaxASCII; r++ {
+				if unicode.Is(preferred, r) {
+					return r, true
+				}
+			}
+		}
+	}
+
+	return ranges[0], true
+}
@@ -13,6 +13,14 @@ type Registry struct {
 	URL       *url.URL
 	IsDefault bool
 
+	// AllowFiles indicates that the values of all variables in the registry
+	// may be read from files, as per [TypedSpecBuilder.AllowFile].
+	AllowFiles bool
+
+	// Lookup is the source of the values of the variables in the registry. If
+	// it is nil, values are obtained from the process's environment.
+	Lookup environment.Lookup
+
 	vars sync.Map // map[string]Any
 }
 
@@ -32,6 +40,8 @@ func (r *Registry) Assign(reg *Registry) {
 	r.Name = reg.Name
 	r.URL = reg.URL
 	r.IsDefault = reg.IsDefault
+	r.AllowFiles = reg.AllowFiles
+	r.Lookup = reg.Lookup
 
 	r.vars.Range(func(k any, _ any) bool {
 		DefaultRegistry.vars.Delete(k)
@@ -77,7 +87,8 @@ func Register[T any](
 	}
 
 	v := &OfType[T]{
-		spec: spec,
+		spec:       spec,
+		registries: registries,
 	}
 
 	for _, reg := range registries {
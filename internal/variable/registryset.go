@@ -3,6 +3,7 @@ package variable
 import (
 	"fmt"
 
+	"github.com/dogmatiq/ferrite/internal/environment"
 	"golang.org/x/exp/slices"
 )
 
@@ -71,11 +72,58 @@ func (s *RegistrySet) Add(r *Registry) {
 	s.registries[r.Key] = r
 }
 
+// Registries returns the registries in the set, sorted by key.
+func (s *RegistrySet) Registries() []*Registry {
+	registries := make([]*Registry, 0, len(s.registries))
+	for _, r := range s.registries {
+		registries = append(registries, r)
+	}
+
+	slices.SortFunc(
+		registries,
+		func(a, b *Registry) bool {
+			return a.Key < b.Key
+		},
+	)
+
+	return registries
+}
+
 // Variables returns the variables in the registries, sorted by name.
 func (s *RegistrySet) Variables() []RegisteredVariable {
 	return s.variables
 }
 
+// Reset discards the resolved values of the variables in the set, such that
+// they are resolved again the next time they are used.
+//
+// lookup is used as the source of values for variables in registries that do
+// not have their own Lookup. If it is nil, values are obtained from the
+// process's environment.
+func (s *RegistrySet) Reset(lookup environment.Lookup) {
+	for _, v := range s.variables {
+		if v, ok := v.Any.(interface{ reset(environment.Lookup) }); ok {
+			v.reset(lookup)
+		}
+	}
+}
+
+// Groups returns the groups that the variables in the registries are members
+// of, in the order that the variables are sorted.
+func (s *RegistrySet) Groups() []*Group {
+	var groups []*Group
+
+	for _, v := range s.variables {
+		for _, g := range Groups(v.Spec()) {
+			if !slices.Contains(groups, g) {
+				groups = append(groups, g)
+			}
+		}
+	}
+
+	return groups
+}
+
 // IsEmpty returns true if the set contains no registries.
 func (s *RegistrySet) IsEmpty() bool {
 	return len(s.registries) == 0
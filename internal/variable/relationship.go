@@ -1,5 +1,7 @@
 package variable
 
+import "golang.org/x/exp/slices"
+
 // Relationship represents a relationship between two variables.
 type Relationship interface {
 	subject() Spec
@@ -75,9 +77,19 @@ func (r RefersTo) object() Spec {
 }
 
 // DependsOn is a relationship type that indicates that a variable requires
-// another variable to be "truthy" in order be used.
+// another variable to be "truthy", or to satisfy some condition, in order be
+// used.
 type DependsOn struct {
 	Subject, DependsOn Spec
+
+	// IsConditional is true if the value of DependsOn must satisfy a condition,
+	// as opposed to merely being "truthy".
+	IsConditional bool
+
+	// Condition is a human-readable description of the condition. It completes
+	// the phrase "when <variable>...". It may be empty if the condition is
+	// not described.
+	Condition string
 }
 
 func (r DependsOn) subject() Spec {
@@ -87,3 +99,140 @@ func (r DependsOn) subject() Spec {
 func (r DependsOn) object() Spec {
 	return r.DependsOn
 }
+
+// RequiredIf is a relationship type that indicates that a variable is required
+// when the value of another variable satisfies some condition.
+type RequiredIf struct {
+	Subject, RequiredIf Spec
+
+	// Condition is a human-readable description of the condition. It completes
+	// the phrase "when <variable>...". It may be empty if the condition is
+	// not described.
+	Condition string
+}
+
+func (r RequiredIf) subject() Spec {
+	return r.Subject
+}
+
+func (r RequiredIf) object() Spec {
+	return r.RequiredIf
+}
+
+// ExactlyOneOf is a relationship type that indicates that a variable is in a
+// group with another variable, and that exactly one of the variable sets in
+// that group must be defined.
+type ExactlyOneOf struct {
+	Subject, ExactlyOneOf Spec
+	Group                 *Group
+}
+
+func (r ExactlyOneOf) subject() Spec {
+	return r.Subject
+}
+
+func (r ExactlyOneOf) object() Spec {
+	return r.ExactlyOneOf
+}
+
+func (r ExactlyOneOf) group() *Group {
+	return r.Group
+}
+
+// AtMostOneOf is a relationship type that indicates that a variable is in a
+// group with another variable, and that no more than one of the variable sets
+// in that group may be defined.
+type AtMostOneOf struct {
+	Subject, AtMostOneOf Spec
+	Group                *Group
+}
+
+func (r AtMostOneOf) subject() Spec {
+	return r.Subject
+}
+
+func (r AtMostOneOf) object() Spec {
+	return r.AtMostOneOf
+}
+
+func (r AtMostOneOf) group() *Group {
+	return r.Group
+}
+
+// AtLeastOneOf is a relationship type that indicates that a variable is in a
+// group with another variable, and that one or more of the variable sets in
+// that group must be defined.
+type AtLeastOneOf struct {
+	Subject, AtLeastOneOf Spec
+	Group                 *Group
+}
+
+func (r AtLeastOneOf) subject() Spec {
+	return r.Subject
+}
+
+func (r AtLeastOneOf) object() Spec {
+	return r.AtLeastOneOf
+}
+
+func (r AtLeastOneOf) group() *Group {
+	return r.Group
+}
+
+// AllOrNoneOf is a relationship type that indicates that a variable is in a
+// group with another variable, and that either all or none of the variable
+// sets in that group must be defined.
+type AllOrNoneOf struct {
+	Subject, AllOrNoneOf Spec
+	Group                *Group
+}
+
+func (r AllOrNoneOf) subject() Spec {
+	return r.Subject
+}
+
+func (r AllOrNoneOf) object() Spec {
+	return r.AllOrNoneOf
+}
+
+func (r AllOrNoneOf) group() *Group {
+	return r.Group
+}
+
+// groupRelationship returns the relationship type that represents the
+// constraint c between two members of the group g.
+func groupRelationship(c GroupConstraint, sub, obj Spec, g *Group) Relationship {
+	switch c {
+	case ExactlyOne:
+		return ExactlyOneOf{sub, obj, g}
+	case AtMostOne:
+		return AtMostOneOf{sub, obj, g}
+	case AtLeastOne:
+		return AtLeastOneOf{sub, obj, g}
+	case AllOrNone:
+		return AllOrNoneOf{sub, obj, g}
+	default:
+		panic("unrecognized group constraint")
+	}
+}
+
+// Groups returns the groups that s is a member of, in the order that they were
+// established.
+func Groups(s Spec) []*Group {
+	var groups []*Group
+
+	for _, rel := range s.Relationships() {
+		if rel.subject() != s {
+			continue
+		}
+
+		if rel, ok := rel.(interface{ group() *Group }); ok {
+			g := rel.group()
+			if !slices.Contains(groups, g) {
+				groups = append(groups, g)
+			}
+		}
+	}
+
+	return groups
+}
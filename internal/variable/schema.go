@@ -44,14 +44,23 @@ type SchemaError interface {
 // SchemaVisitor dispatches based on a variable's schema.
 type SchemaVisitor interface {
 	VisitBinary(Binary)
+	VisitMap(Map)
 	VisitNumeric(Numeric)
 	VisitSet(Set)
+	VisitSlice(Slice)
 	VisitString(String)
 	VisitOther(Other)
 }
 
 // SchemaErrorVisitor dispatches based on the type of a SchemaError.
 type SchemaErrorVisitor interface {
+	// Map errors ...
+	VisitEntryError(EntryError)
+	VisitKeyError(KeyError)
+	VisitEntryValueError(EntryValueError)
+	VisitDuplicateKeyError(DuplicateKeyError)
+	VisitMissingKeyError(MissingKeyError)
+
 	// Numeric errors ...
 	VisitMinError(MinError)
 	VisitMaxError(MaxError)
@@ -59,9 +68,14 @@ type SchemaErrorVisitor interface {
 	// Set errors...
 	VisitSetMembershipError(SetMembershipError)
 
+	// Slice errors ...
+	VisitElementError(ElementError)
+	VisitDuplicateElementError(DuplicateElementError)
+
 	// String errors ...
 	VisitMinLengthError(MinLengthError)
 	VisitMaxLengthError(MaxLengthError)
+	VisitPatternError(PatternError)
 }
 
 // TypedSchema describes the valid values of an environment varible value
@@ -131,7 +131,7 @@ func (s TypedBinary[T, B]) validate(v T) error {
 	}
 
 	if max, ok := s.MaxLen.Get(); ok && len(v) > max {
-		return MinLengthError{s}
+		return MaxLengthError{s}
 	}
 
 	return nil
@@ -0,0 +1,469 @@
+package variable
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/dogmatiq/ferrite/internal/reflectx"
+	"golang.org/x/exp/slices"
+)
+
+// Map is a schema that allows a set of key/value pairs, where the keys and
+// values are each described by their own specification.
+type Map interface {
+	Schema
+
+	// Key returns the specification that applies to each key of the map.
+	Key() Spec
+
+	// Value returns the specification that applies to each value of the map.
+	Value() Spec
+
+	// PairSeparator returns the string used to separate each key from its
+	// value within the literal representation of the map.
+	PairSeparator() string
+
+	// EntrySeparator returns the string used to separate key/value pairs
+	// within the literal representation of the map.
+	EntrySeparator() string
+
+	// RequiredKeys returns the keys that must be present in the map.
+	RequiredKeys() []Literal
+}
+
+// TypedMap is a map of keys of type K to values of type V.
+type TypedMap[K comparable, V any] struct {
+	KeySpec   *TypedSpec[K]
+	ValueSpec *TypedSpec[V]
+	PairSep   string
+	EntrySep  string
+	ReqKeys   []K
+}
+
+// Key returns the specification that applies to each key of the map.
+func (s TypedMap[K, V]) Key() Spec {
+	return s.KeySpec
+}
+
+// Value returns the specification that applies to each value of the map.
+func (s TypedMap[K, V]) Value() Spec {
+	return s.ValueSpec
+}
+
+// PairSeparator returns the string used to separate each key from its value
+// within the literal representation of the map.
+func (s TypedMap[K, V]) PairSeparator() string {
+	return s.PairSep
+}
+
+// EntrySeparator returns the string used to separate key/value pairs within
+// the literal representation of the map.
+func (s TypedMap[K, V]) EntrySeparator() string {
+	return s.EntrySep
+}
+
+// RequiredKeys returns the keys that must be present in the map.
+func (s TypedMap[K, V]) RequiredKeys() []Literal {
+	var keys []Literal
+
+	for _, k := range s.ReqKeys {
+		lit, err := s.KeySpec.Marshal(k)
+		if err != nil {
+			// The keys have already been validated by Finalize().
+			panic(err)
+		}
+
+		if !slices.Contains(keys, lit) {
+			keys = append(keys, lit)
+		}
+	}
+
+	return keys
+}
+
+// Type returns the type of the native value.
+func (s TypedMap[K, V]) Type() reflect.Type {
+	return reflectx.TypeOf[map[K]V]()
+}
+
+// Finalize prepares the schema for use.
+//
+// It returns an error if schema is invalid.
+func (s TypedMap[K, V]) Finalize() error {
+	if strings.TrimSpace(s.PairSep) == "" {
+		return errors.New("pair separator must not be empty or whitespace")
+	}
+
+	if strings.TrimSpace(s.EntrySep) == "" {
+		return errors.New("entry separator must not be empty or whitespace")
+	}
+
+	if strings.Contains(s.PairSep, s.EntrySep) || strings.Contains(s.EntrySep, s.PairSep) {
+		return errors.New("pair and entry separators must not overlap")
+	}
+
+	for _, k := range s.ReqKeys {
+		if _, err := s.KeySpec.Marshal(k); err != nil {
+			return fmt.Errorf("required keys: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// AcceptVisitor passes s to the appropriate method of v.
+func (s TypedMap[K, V]) AcceptVisitor(v SchemaVisitor) {
+	v.VisitMap(s)
+}
+
+// Marshal converts a value to its literal representation.
+//
+// The entries are sorted by the literal representation of their keys.
+func (s TypedMap[K, V]) Marshal(v map[K]V) (Literal, error) {
+	// An empty map is represented by an empty literal, which is equivalent to
+	// leaving the variable undefined.
+	if len(v) == 0 {
+		return Literal{}, nil
+	}
+
+	entries := make([]mapEntry, 0, len(v))
+
+	for k, n := range v {
+		key, err := s.KeySpec.Marshal(k)
+		if err != nil {
+			return Literal{}, KeyError{s, key, err}
+		}
+
+		value, err := s.ValueSpec.Marshal(n)
+		if err != nil {
+			return Literal{}, EntryValueError{s, key, value, err}
+		}
+
+		entries = append(entries, mapEntry{key, value})
+	}
+
+	if err := s.validate(entries); err != nil {
+		return Literal{}, err
+	}
+
+	return s.join(entries), nil
+}
+
+// Unmarshal converts a literal value to it's native representation.
+func (s TypedMap[K, V]) Unmarshal(v Literal) (map[K]V, error) {
+	parts := strings.Split(v.String, s.EntrySep)
+	native := make(map[K]V, len(parts))
+	entries := make([]mapEntry, 0, len(parts))
+
+	for i, p := range parts {
+		entry := Literal{
+			String: strings.TrimSpace(p),
+		}
+
+		k, n, ok := strings.Cut(entry.String, s.PairSep)
+		key := Literal{String: strings.TrimSpace(k)}
+		value := Literal{String: strings.TrimSpace(n)}
+
+		if !ok || key.String == "" || value.String == "" {
+			return nil, EntryError{s, i, entry}
+		}
+
+		nk, ck, err := s.KeySpec.Unmarshal(key)
+		if err != nil {
+			return nil, KeyError{s, key, err}
+		}
+
+		if indexOfKey(entries, ck) != -1 {
+			return nil, DuplicateKeyError{s, key}
+		}
+
+		nv, cv, err := s.ValueSpec.Unmarshal(value)
+		if err != nil {
+			return nil, EntryValueError{s, key, value, err}
+		}
+
+		native[nk] = nv
+		entries = append(entries, mapEntry{ck, cv})
+	}
+
+	return native, s.validate(entries)
+}
+
+// Examples returns a (possibly empty) set of examples of valid values.
+func (s TypedMap[K, V]) Examples(conservative bool) []TypedExample[map[K]V] {
+	var keys []K
+	var canonical []Literal
+	normative := true
+
+	addKey := func(n K) {
+		c, err := s.KeySpec.Marshal(n)
+		if err == nil && !slices.Contains(canonical, c) {
+			keys = append(keys, n)
+			canonical = append(canonical, c)
+		}
+	}
+
+	for _, k := range s.ReqKeys {
+		addKey(k)
+	}
+
+	for _, eg := range s.KeySpec.Examples() {
+		if n, _, err := s.KeySpec.Unmarshal(eg.Canonical); err == nil {
+			addKey(n)
+			normative = normative && eg.IsNormative
+		}
+	}
+
+	var values []V
+
+	for _, eg := range s.ValueSpec.Examples() {
+		if n, _, err := s.ValueSpec.Unmarshal(eg.Canonical); err == nil {
+			values = append(values, n)
+			normative = normative && eg.IsNormative
+		}
+	}
+
+	if len(keys) == 0 || len(values) == 0 {
+		return nil
+	}
+
+	// Always include the required keys, and show no more than 3 entries
+	// otherwise.
+	min := len(s.ReqKeys)
+	if min == 0 {
+		min = 1
+	}
+
+	max := len(keys)
+	if max > 3 {
+		max = 3
+	}
+
+	sizes := []int{min}
+	if max > min {
+		sizes = append(sizes, max)
+	}
+
+	var examples []TypedExample[map[K]V]
+
+	for _, size := range sizes {
+		example := make(map[K]V, size)
+		for i := 0; i < size && i < len(keys); i++ {
+			example[keys[i]] = values[i%len(values)]
+		}
+
+		examples = append(examples, TypedExample[map[K]V]{
+			Native:      example,
+			IsNormative: normative,
+		})
+	}
+
+	return examples
+}
+
+// mapEntry is the literal representation of a single key/value pair.
+type mapEntry struct {
+	Key, Value Literal
+}
+
+// validate returns an error if the given entries are invalid.
+func (s TypedMap[K, V]) validate(entries []mapEntry) error {
+	for _, k := range s.RequiredKeys() {
+		if indexOfKey(entries, k) == -1 {
+			return MissingKeyError{s, k}
+		}
+	}
+
+	return nil
+}
+
+// indexOfKey returns the index of the entry with the given key, or -1 if there
+// is no such entry.
+func indexOfKey(entries []mapEntry, k Literal) int {
+	return slices.IndexFunc(
+		entries,
+		func(e mapEntry) bool {
+			return e.Key == k
+		},
+	)
+}
+
+// join returns the literal representation of a map from the literal
+// representations of its entries.
+func (s TypedMap[K, V]) join(entries []mapEntry) Literal {
+	slices.SortFunc(
+		entries,
+		func(a, b mapEntry) bool {
+			return a.Key.String < b.Key.String
+		},
+	)
+
+	parts := make([]string, len(entries))
+	for i, e := range entries {
+		parts[i] = e.Key.String + s.PairSep + e.Value.String
+	}
+
+	return Literal{
+		String: strings.Join(parts, s.EntrySep),
+	}
+}
+
+// EntryError indicates that an entry of a map is not a valid key/value pair.
+type EntryError struct {
+	Map Map
+
+	// Index is the zero-based index of the invalid entry.
+	Index int
+	Entry Literal
+}
+
+var _ SchemaError = EntryError{}
+
+// Schema returns the schema that was violated.
+func (e EntryError) Schema() Schema {
+	return e.Map
+}
+
+// AcceptVisitor passes the error to the appropriate method of v.
+func (e EntryError) AcceptVisitor(v SchemaErrorVisitor) {
+	v.VisitEntryError(e)
+}
+
+func (e EntryError) Error() string {
+	return fmt.Sprintf(
+		"entry %d (%s) is invalid, expected a key and value separated by %s",
+		e.Index+1,
+		e.Entry.Quote(),
+		Literal{String: e.Map.PairSeparator()}.Quote(),
+	)
+}
+
+// KeyError indicates that a key of a map is invalid.
+type KeyError struct {
+	Map   Map
+	Key   Literal
+	Cause error
+}
+
+var _ SchemaError = KeyError{}
+
+// Schema returns the schema that was violated.
+func (e KeyError) Schema() Schema {
+	return e.Map
+}
+
+// AcceptVisitor passes the error to the appropriate method of v.
+func (e KeyError) AcceptVisitor(v SchemaErrorVisitor) {
+	v.VisitKeyError(e)
+}
+
+// AcceptCauseVisitor passes the cause of the error to the appropriate method of
+// v.
+func (e KeyError) AcceptCauseVisitor(v ValueErrorVisitor) {
+	acceptValueErrorVisitor(e.Cause, v)
+}
+
+func (e KeyError) Unwrap() error {
+	return e.Cause
+}
+
+func (e KeyError) Error() string {
+	return fmt.Sprintf(
+		"key %s is invalid: %s",
+		e.Key.Quote(),
+		e.Cause,
+	)
+}
+
+// EntryValueError indicates that the value associated with a specific key of a
+// map is invalid.
+type EntryValueError struct {
+	Map   Map
+	Key   Literal
+	Value Literal
+	Cause error
+}
+
+var _ SchemaError = EntryValueError{}
+
+// Schema returns the schema that was violated.
+func (e EntryValueError) Schema() Schema {
+	return e.Map
+}
+
+// AcceptVisitor passes the error to the appropriate method of v.
+func (e EntryValueError) AcceptVisitor(v SchemaErrorVisitor) {
+	v.VisitEntryValueError(e)
+}
+
+// AcceptCauseVisitor passes the cause of the error to the appropriate method of
+// v.
+func (e EntryValueError) AcceptCauseVisitor(v ValueErrorVisitor) {
+	acceptValueErrorVisitor(e.Cause, v)
+}
+
+func (e EntryValueError) Unwrap() error {
+	return e.Cause
+}
+
+func (e EntryValueError) Error() string {
+	return fmt.Sprintf(
+		"value of key %s (%s) is invalid: %s",
+		e.Key.Quote(),
+		e.Value.Quote(),
+		e.Cause,
+	)
+}
+
+// DuplicateKeyError indicates that a map contains the same key more than once.
+type DuplicateKeyError struct {
+	Map Map
+	Key Literal
+}
+
+var _ SchemaError = DuplicateKeyError{}
+
+// Schema returns the schema that was violated.
+func (e DuplicateKeyError) Schema() Schema {
+	return e.Map
+}
+
+// AcceptVisitor passes the error to the appropriate method of v.
+func (e DuplicateKeyError) AcceptVisitor(v SchemaErrorVisitor) {
+	v.VisitDuplicateKeyError(e)
+}
+
+func (e DuplicateKeyError) Error() string {
+	return fmt.Sprintf(
+		"key %s is specified more than once, expected unique keys",
+		e.Key.Quote(),
+	)
+}
+
+// MissingKeyError indicates that a map does not contain one of its required
+// keys.
+type MissingKeyError struct {
+	Map Map
+	Key Literal
+}
+
+var _ SchemaError = MissingKeyError{}
+
+// Schema returns the schema that was violated.
+func (e MissingKeyError) Schema() Schema {
+	return e.Map
+}
+
+// AcceptVisitor passes the error to the appropriate method of v.
+func (e MissingKeyError) AcceptVisitor(v SchemaErrorVisitor) {
+	v.VisitMissingKeyError(e)
+}
+
+func (e MissingKeyError) Error() string {
+	return fmt.Sprintf(
+		"missing required key %s",
+		e.Key.Quote(),
+	)
+}
@@ -67,7 +67,7 @@ func (s TypedNumeric[T]) Limits() (min, max Literal, explicit bool) {
 	}
 
 	if v, ok := s.NativeMax.Get(); ok {
-		lower = v
+		upper = v
 	} else {
 		explicit = false
 	}
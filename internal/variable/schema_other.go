@@ -20,6 +20,10 @@ type Other interface {
 // explanation of the value.
 type TypedOther[T any] struct {
 	Marshaler Marshaler[T]
+
+	// Check is an optional function that returns an error if the
+	// configuration of the schema is invalid. It is called by Finalize().
+	Check func() error
 }
 
 // Type returns the type of the native value.
@@ -31,6 +35,9 @@ func (s TypedOther[T]) Type() reflect.Type {
 //
 // It returns an error if schema is invalid.
 func (s TypedOther[T]) Finalize() error {
+	if s.Check != nil {
+		return s.Check()
+	}
 	return nil
 }
 
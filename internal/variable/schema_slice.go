@@ -0,0 +1,321 @@
+package variable
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/dogmatiq/ferrite/internal/maybe"
+	"github.com/dogmatiq/ferrite/internal/reflectx"
+)
+
+// Slice is a schema that allows a list of values, each of which is described by
+// its own specification.
+type Slice interface {
+	LengthLimited
+
+	// Element returns the specification that applies to each element of the
+	// slice.
+	Element() Spec
+
+	// Separator returns the string used to separate elements within the
+	// literal representation of the slice.
+	Separator() string
+
+	// IsUnique returns true if the elements of the slice must be distinct.
+	IsUnique() bool
+}
+
+// TypedSlice is a slice of values of type T.
+type TypedSlice[T any] struct {
+	ElementSpec    *TypedSpec[T]
+	Sep            string
+	MinLen, MaxLen maybe.Value[int]
+	Unique         bool
+}
+
+// Element returns the specification that applies to each element of the
+// slice.
+func (s TypedSlice[T]) Element() Spec {
+	return s.ElementSpec
+}
+
+// Separator returns the string used to separate elements within the literal
+// representation of the slice.
+func (s TypedSlice[T]) Separator() string {
+	return s.Sep
+}
+
+// IsUnique returns true if the elements of the slice must be distinct.
+func (s TypedSlice[T]) IsUnique() bool {
+	return s.Unique
+}
+
+// MinLength returns the minimum permitted number of elements.
+func (s TypedSlice[T]) MinLength() (int, bool) {
+	return s.MinLen.Get()
+}
+
+// MaxLength returns the maximum permitted number of elements.
+func (s TypedSlice[T]) MaxLength() (int, bool) {
+	return s.MaxLen.Get()
+}
+
+// Type returns the type of the native value.
+func (s TypedSlice[T]) Type() reflect.Type {
+	return reflectx.TypeOf[[]T]()
+}
+
+// Finalize prepares the schema for use.
+//
+// It returns an error if schema is invalid.
+func (s TypedSlice[T]) Finalize() error {
+	if strings.TrimSpace(s.Sep) == "" {
+		return errors.New("separator must not be empty or whitespace")
+	}
+
+	min := 1
+
+	if v, ok := s.MinLen.Get(); ok {
+		if v < min {
+			return fmt.Errorf("minimum length: must be at least %d", min)
+		}
+		min = v
+	}
+
+	if v, ok := s.MaxLen.Get(); ok {
+		if v < min {
+			return fmt.Errorf("maximum length: must be at least %d", min)
+		}
+	}
+
+	return nil
+}
+
+// AcceptVisitor passes s to the appropriate method of v.
+func (s TypedSlice[T]) AcceptVisitor(v SchemaVisitor) {
+	v.VisitSlice(s)
+}
+
+// Marshal converts a value to its literal representation.
+func (s TypedSlice[T]) Marshal(v []T) (Literal, error) {
+	// An empty slice is represented by an empty literal, which is equivalent
+	// to leaving the variable undefined.
+	if len(v) == 0 {
+		return Literal{}, nil
+	}
+
+	elements := make([]Literal, len(v))
+
+	for i, n := range v {
+		lit, err := s.ElementSpec.Marshal(n)
+		if err != nil {
+			return Literal{}, ElementError{s, i, lit, err}
+		}
+		elements[i] = lit
+	}
+
+	if err := s.validate(elements); err != nil {
+		return Literal{}, err
+	}
+
+	return s.join(elements), nil
+}
+
+// Unmarshal converts a literal value to it's native representation.
+func (s TypedSlice[T]) Unmarshal(v Literal) ([]T, error) {
+	parts := strings.Split(v.String, s.Sep)
+	native := make([]T, len(parts))
+	elements := make([]Literal, len(parts))
+
+	for i, p := range parts {
+		lit := Literal{
+			String: strings.TrimSpace(p),
+		}
+
+		if lit.String == "" {
+			return nil, ElementError{s, i, lit, errors.New("must not be empty")}
+		}
+
+		n, c, err := s.ElementSpec.Unmarshal(lit)
+		if err != nil {
+			return nil, ElementError{s, i, lit, err}
+		}
+
+		native[i] = n
+		elements[i] = c
+	}
+
+	return native, s.validate(elements)
+}
+
+// Examples returns a (possibly empty) set of examples of valid values.
+func (s TypedSlice[T]) Examples(conservative bool) []TypedExample[[]T] {
+	var candidates []T
+	normative := true
+
+	for _, eg := range s.ElementSpec.Examples() {
+		n, _, err := s.ElementSpec.Unmarshal(eg.Canonical)
+		if err != nil {
+			continue
+		}
+
+		candidates = append(candidates, n)
+		normative = normative && eg.IsNormative
+	}
+
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	min, ok := s.MinLen.Get()
+	if !ok {
+		min = 1
+	}
+
+	// Show no more than 3 elements, and don't repeat elements unless the
+	// minimum length requires it.
+	max := len(candidates)
+	if max > 3 {
+		max = 3
+	}
+	if v, ok := s.MaxLen.Get(); ok && v < max {
+		max = v
+	}
+
+	sizes := []int{min}
+	if max > min {
+		sizes = append(sizes, max)
+	}
+
+	var examples []TypedExample[[]T]
+
+	for _, size := range sizes {
+		example := make([]T, size)
+		for i := range example {
+			example[i] = candidates[i%len(candidates)]
+		}
+
+		examples = append(examples, TypedExample[[]T]{
+			Native:      example,
+			IsNormative: normative,
+		})
+	}
+
+	return examples
+}
+
+// validate returns an error if the given element literals are invalid.
+func (s TypedSlice[T]) validate(elements []Literal) error {
+	if min, ok := s.MinLen.Get(); ok && len(elements) < min {
+		return MinLengthError{s}
+	}
+
+	if max, ok := s.MaxLen.Get(); ok && len(elements) > max {
+		return MaxLengthError{s}
+	}
+
+	if s.Unique {
+		seen := map[Literal]int{}
+
+		for i, lit := range elements {
+			if j, ok := seen[lit]; ok {
+				return DuplicateElementError{s, i, j, lit}
+			}
+			seen[lit] = i
+		}
+	}
+
+	return nil
+}
+
+// join returns the literal representation of a slice from the literal
+// representations of its elements.
+func (s TypedSlice[T]) join(elements []Literal) Literal {
+	parts := make([]string, len(elements))
+	for i, lit := range elements {
+		parts[i] = lit.String
+	}
+
+	return Literal{
+		String: strings.Join(parts, s.Sep),
+	}
+}
+
+// ElementError indicates that a specific element of a slice is invalid.
+type ElementError struct {
+	Slice Slice
+
+	// Index is the zero-based index of the invalid element.
+	Index   int
+	Element Literal
+	Cause   error
+}
+
+var _ SchemaError = ElementError{}
+
+// Schema returns the schema that was violated.
+func (e ElementError) Schema() Schema {
+	return e.Slice
+}
+
+// AcceptVisitor passes the error to the appropriate method of v.
+func (e ElementError) AcceptVisitor(v SchemaErrorVisitor) {
+	v.VisitElementError(e)
+}
+
+// AcceptCauseVisitor passes the cause of the error to the appropriate method of
+// v.
+func (e ElementError) AcceptCauseVisitor(v ValueErrorVisitor) {
+	acceptValueErrorVisitor(e.Cause, v)
+}
+
+func (e ElementError) Unwrap() error {
+	return e.Cause
+}
+
+func (e ElementError) Error() string {
+	return fmt.Sprintf(
+		"element %d (%s) is invalid: %s",
+		e.Index+1,
+		e.Element.Quote(),
+		e.Cause,
+	)
+}
+
+// DuplicateElementError indicates that a slice that requires unique elements
+// contains the same element more than once.
+type DuplicateElementError struct {
+	Slice Slice
+
+	// Index is the zero-based index of the duplicate element.
+	Index int
+
+	// FirstIndex is the zero-based index of the first occurrence of the
+	// element.
+	FirstIndex int
+
+	Element Literal
+}
+
+var _ SchemaError = DuplicateElementError{}
+
+// Schema returns the schema that was violated.
+func (e DuplicateElementError) Schema() Schema {
+	return e.Slice
+}
+
+// AcceptVisitor passes the error to the appropriate method of v.
+func (e DuplicateElementError) AcceptVisitor(v SchemaErrorVisitor) {
+	v.VisitDuplicateElementError(e)
+}
+
+func (e DuplicateElementError) Error() string {
+	return fmt.Sprintf(
+		"element %d (%s) is a duplicate of element %d, expected unique elements",
+		e.Index+1,
+		e.Element.Quote(),
+		e.FirstIndex+1,
+	)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math"
 	"reflect"
+	"regexp"
 
 	"github.com/dogmatiq/ferrite/internal/maybe"
 	"github.com/dogmatiq/ferrite/internal/reflectx"
@@ -12,11 +13,15 @@ import (
 // String is a schema that allows arbitrary string input.
 type String interface {
 	LengthLimited
+
+	// Pattern returns the regular expression that the value must match.
+	Pattern() (*regexp.Regexp, bool)
 }
 
 // TypedString is a string value depicted by type T.
 type TypedString[T ~string] struct {
 	MinLen, MaxLen maybe.Value[int]
+	Regexp         *regexp.Regexp
 }
 
 // MinLength returns the minimum permitted length of the native value.
@@ -29,6 +34,11 @@ func (s TypedString[T]) MaxLength() (int, bool) {
 	return s.MaxLen.Get()
 }
 
+// Pattern returns the regular expression that the value must match.
+func (s TypedString[T]) Pattern() (*regexp.Regexp, bool) {
+	return s.Regexp, s.Regexp != nil
+}
+
 // Type returns the type of the native value.
 func (s TypedString[T]) Type() reflect.Type {
 	return reflectx.TypeOf[T]()
@@ -80,6 +90,18 @@ func (s TypedString[T]) Unmarshal(v Literal) (T, error) {
 
 // Examples returns a (possibly empty) set of examples of valid values.
 func (s TypedString[T]) Examples(hasOtherExamples bool) []TypedExample[T] {
+	if s.Regexp != nil {
+		// An example derived from the pattern is always useful, as it's
+		// otherwise difficult to describe what a valid value looks like.
+		if eg, ok := patternExample(s.Regexp); ok && s.validate(T(eg)) == nil {
+			return []TypedExample[T]{
+				{
+					Native: T(eg),
+				},
+			}
+		}
+	}
+
 	if hasOtherExamples {
 		return nil
 	}
@@ -134,8 +156,35 @@ func (s TypedString[T]) validate(v T) error {
 	}
 
 	if max, ok := s.MaxLen.Get(); ok && len(v) > max {
-		return MinLengthError{s}
+		return MaxLengthError{s}
+	}
+
+	if s.Regexp != nil && !s.Regexp.MatchString(string(v)) {
+		return PatternError{s}
 	}
 
 	return nil
 }
+
+// PatternError indicates that a value did not match the regular expression
+// required by its schema.
+type PatternError struct {
+	ViolatedSchema String
+}
+
+var _ SchemaError = PatternError{}
+
+// Schema returns the schema that was violated.
+func (e PatternError) Schema() Schema {
+	return e.ViolatedSchema
+}
+
+// AcceptVisitor passes the error to the appropriate method of v.
+func (e PatternError) AcceptVisitor(v SchemaErrorVisitor) {
+	v.VisitPatternError(e)
+}
+
+func (e PatternError) Error() string {
+	re, _ := e.ViolatedSchema.Pattern()
+	return fmt.Sprintf("expected a value matching the pattern %s", re)
+}
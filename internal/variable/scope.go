@@ -0,0 +1,35 @@
+package variable
+
+import "github.com/dogmatiq/ferrite/internal/environment"
+
+// Scope is a context in which variables are resolved independently of the
+// values that they resolve to when used directly.
+//
+// Resolving a variable within a scope does not affect the variable itself, or
+// any other scope. A scope is not safe for concurrent use.
+type Scope struct {
+	lookup environment.Lookup
+	vars   map[Any]Any
+}
+
+// NewScope returns a new scope in which variables obtain their values from
+// lookup, unless one of their registries has its own Lookup.
+//
+// If lookup is nil, values are obtained from the process's environment.
+func NewScope(lookup environment.Lookup) *Scope {
+	return &Scope{
+		lookup: lookup,
+		vars:   map[Any]Any{},
+	}
+}
+
+// In returns v as it is resolved within s.
+//
+// If s is nil, it returns v itself.
+func In(v Any, s *Scope) Any {
+	if s == nil {
+		return v
+	}
+
+	return v.(interface{ in(*Scope) Any }).in(s)
+}
@@ -34,6 +34,15 @@ type Spec interface {
 	// IsDeprecated returns true if the variable is deprecated.
 	IsDeprecated() bool
 
+	// IsDefinedByKubernetes returns true if the variable is implicitly defined
+	// by Kubernetes, and therefore does not need to be specified in a pod
+	// manifest.
+	IsDefinedByKubernetes() bool
+
+	// Aliases returns alternative names for the variable, in the order that
+	// they are consulted when the variable itself is undefined.
+	Aliases() []string
+
 	// Constraints returns a list of additional constraints on the variable's
 	// value.
 	Constraints() []Constraint
@@ -70,12 +79,16 @@ type TypedSpec[T any] struct {
 	required      bool
 	sensitive     bool
 	deprecated    bool
+	allowFile     bool
+	aliases       []string
+	kubernetes    bool
 	schema        TypedSchema[T]
 	examples      []Example
 	docs          []Documentation
 	constraints   []TypedConstraint[T]
 	relationships []Relationship
-	preconditions []func() bool
+	preconditions []func(*Scope) bool
+	requirements  []func(*Scope) bool
 }
 
 // Name returns the name of the variable.
@@ -125,6 +138,18 @@ func (s *TypedSpec[T]) IsDeprecated() bool {
 	return s.deprecated
 }
 
+// IsDefinedByKubernetes returns true if the variable is implicitly defined by
+// Kubernetes, and therefore does not need to be specified in a pod manifest.
+func (s *TypedSpec[T]) IsDefinedByKubernetes() bool {
+	return s.kubernetes
+}
+
+// Aliases returns alternative names for the variable, in the order that they
+// are consulted when the variable itself is undefined.
+func (s *TypedSpec[T]) Aliases() []string {
+	return s.aliases
+}
+
 // Constraints returns a list of additional constraints on the variable's
 // value.
 func (s *TypedSpec[T]) Constraints() []Constraint {
@@ -160,7 +185,7 @@ func (s *TypedSpec[T]) addRelationship(r Relationship) {
 func (s *TypedSpec[T]) CheckConstraints(v T) ConstraintError {
 	for _, c := range s.constraints {
 		if err := c.Check(v); err != nil {
-			return err
+			return ConstraintViolation{c, err}
 		}
 	}
 
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/dogmatiq/ferrite/internal/environment"
 	"github.com/dogmatiq/ferrite/internal/maybe"
 )
 
@@ -14,8 +15,11 @@ type SpecBuilder interface {
 	MarkRequired()
 	MarkDeprecated()
 	MarkSensitive()
+	AllowFile()
+	Alias(string)
 	Documentation() DocumentationBuilder
-	Precondition(func() bool)
+	Precondition(func(*Scope) bool)
+	Requirement(func(*Scope) bool)
 	Peek() Spec
 }
 
@@ -76,6 +80,35 @@ func (b *TypedSpecBuilder[T]) UserConstraint(
 	)
 }
 
+// UserConstraintFunc adds a user-defined constraint to the variable's value.
+//
+// fn returns an error that describes why the value does not satisfy the
+// constraint, or nil if the value is valid.
+func (b *TypedSpecBuilder[T]) UserConstraintFunc(
+	desc string,
+	fn func(T) error,
+) {
+	b.spec.constraints = append(
+		b.spec.constraints,
+		constraint[T]{
+			desc,
+			true,
+			func(v T) ConstraintError {
+				if err := fn(v); err != nil {
+					return err
+				}
+				return nil
+			},
+		},
+	)
+}
+
+// CheckConstraints returns an error if v does not meet the constraints that
+// have been added to the variable so far.
+func (b *TypedSpecBuilder[T]) CheckConstraints(v T) ConstraintError {
+	return b.spec.CheckConstraints(v)
+}
+
 // MarkRequired marks the variable as required.
 func (b *TypedSpecBuilder[T]) MarkRequired() {
 	b.spec.required = true
@@ -91,6 +124,26 @@ func (b *TypedSpecBuilder[T]) MarkDeprecated() {
 	b.spec.deprecated = true
 }
 
+// MarkDefinedByKubernetes marks the variable as being implicitly defined by
+// Kubernetes.
+func (b *TypedSpecBuilder[T]) MarkDefinedByKubernetes() {
+	b.spec.kubernetes = true
+}
+
+// AllowFile allows the variable's value to be read from a file, the path of
+// which is specified by an environment variable with a "_FILE" suffix.
+func (b *TypedSpecBuilder[T]) AllowFile() {
+	b.spec.allowFile = true
+}
+
+// Alias adds an alternative name for the variable.
+//
+// The value of the alias is used if the variable itself is undefined. Aliases
+// are consulted in the order that they are added.
+func (b *TypedSpecBuilder[T]) Alias(name string) {
+	b.spec.aliases = append(b.spec.aliases, name)
+}
+
 // NormativeExample adds a normative example to the variable.
 //
 // A normative example is one that is meaningful in the context of the
@@ -124,11 +177,24 @@ func (b *TypedSpecBuilder[T]) Documentation() DocumentationBuilder {
 // variable's value to be made available.
 //
 // If any precondition fails the variable is treated as though it were undefined
-// and without a default value.
-func (b *TypedSpecBuilder[T]) Precondition(fn func() bool) {
+// and without a default value. fn is called with the scope in which the
+// variable is being resolved, which is nil if it is not resolved within a
+// [Scope].
+func (b *TypedSpecBuilder[T]) Precondition(fn func(*Scope) bool) {
 	b.spec.preconditions = append(b.spec.preconditions, fn)
 }
 
+// Requirement adds a predicate that causes the variable to be treated as
+// required when it is satisfied.
+//
+// If any requirement is satisfied and the variable has neither a value nor a
+// default value, it is treated as though it were marked as required. fn is
+// called with the scope in which the variable is being resolved, as per
+// [TypedSpecBuilder.Precondition].
+func (b *TypedSpecBuilder[T]) Requirement(fn func(*Scope) bool) {
+	b.spec.requirements = append(b.spec.requirements, fn)
+}
+
 // Peek returns the (potentially invalid) spec that is being built.
 func (b *TypedSpecBuilder[T]) Peek() Spec {
 	return &b.spec
@@ -159,6 +225,28 @@ func (b *TypedSpecBuilder[T]) finalize() error {
 		}
 	}
 
+	names := map[string]struct{}{
+		environment.NormalizeName(b.spec.name): {},
+	}
+
+	for _, alias := range b.spec.aliases {
+		if alias == "" {
+			return SpecError{
+				name:  b.spec.name,
+				cause: errors.New("alias must not be empty"),
+			}
+		}
+
+		n := environment.NormalizeName(alias)
+		if _, ok := names[n]; ok {
+			return SpecError{
+				name:  b.spec.name,
+				cause: fmt.Errorf("alias %s is already used by this variable", alias),
+			}
+		}
+		names[n] = struct{}{}
+	}
+
 	if err := b.spec.schema.Finalize(); err != nil {
 		return SpecError{
 			name:  b.spec.name,
@@ -70,6 +70,7 @@ type ValueError interface {
 type ValueErrorVisitor interface {
 	SchemaErrorVisitor
 
+	VisitConstraintViolation(ConstraintViolation)
 	VisitGenericError(error)
 }
 
@@ -93,12 +94,7 @@ func (e valueError) Unwrap() error {
 }
 
 func (e valueError) AcceptVisitor(v ValueErrorVisitor) {
-	switch err := e.cause.(type) {
-	case SchemaError:
-		err.AcceptVisitor(v)
-	default:
-		v.VisitGenericError(err)
-	}
+	acceptValueErrorVisitor(e.cause, v)
 }
 
 func (e valueError) Error() string {
@@ -109,3 +105,16 @@ func (e valueError) Error() string {
 		e.cause,
 	)
 }
+
+// acceptValueErrorVisitor passes err, which is the cause of a value error, to
+// the appropriate method of v.
+func acceptValueErrorVisitor(err error, v ValueErrorVisitor) {
+	switch err := err.(type) {
+	case SchemaError:
+		err.AcceptVisitor(v)
+	case ConstraintViolation:
+		v.VisitConstraintViolation(err)
+	default:
+		v.VisitGenericError(err)
+	}
+}
@@ -2,6 +2,8 @@ package variable
 
 import (
 	"fmt"
+	"os"
+	"strings"
 	"sync"
 
 	"github.com/dogmatiq/ferrite/internal/environment"
@@ -45,24 +47,42 @@ const (
 	// SourceEnvironment indicates that the value was obtained from the
 	// environment.
 	SourceEnvironment
+
+	// SourceFile indicates that the value was read from a file, the path of
+	// which was obtained from the environment.
+	SourceFile
 )
 
 // Any is an interface for an environment variable of any type.
 type Any interface {
 	Spec() Spec
+	FileVariableName() (string, bool)
 	Availability() Availability
 	Source() Source
+	File() string
+	Origin() string
+	Alias() string
+	Fallback() string
 	Value() Value
 	Error() Error
 }
 
 // OfType is an environment variable depicted by type T.
 type OfType[T any] struct {
-	spec *TypedSpec[T]
+	spec       *TypedSpec[T]
+	registries []*Registry
+	fallbacks  []fallback
+	scope      *Scope
 
-	once         sync.Once
+	m            sync.Mutex
+	resolved     bool
+	lookup       environment.Lookup
 	availability Availability
 	source       Source
+	file         string
+	origin       string
+	alias        string
+	fallback     string
 	value        valueOf[T]
 	err          Error
 }
@@ -72,6 +92,25 @@ func (v *OfType[T]) Spec() Spec {
 	return v.spec
 }
 
+// FileVariableName returns the name of the environment variable that may
+// contain the path of a file from which this variable's value is read.
+//
+// Reading from a file is allowed if it is allowed by the variable's
+// specification, or by any of the registries that the variable belongs to.
+func (v *OfType[T]) FileVariableName() (string, bool) {
+	allow := v.spec.allowFile
+
+	for _, reg := range v.registries {
+		allow = allow || reg.AllowFiles
+	}
+
+	if allow {
+		return v.spec.name + "_FILE", true
+	}
+
+	return "", false
+}
+
 // Availability returns the variable's availability.
 func (v *OfType[T]) Availability() Availability {
 	v.resolve()
@@ -84,6 +123,43 @@ func (v *OfType[T]) Source() Source {
 	return v.source
 }
 
+// File returns the path of the file that the variable's value was read from.
+//
+// It returns an empty string unless the variable's source is SourceFile.
+func (v *OfType[T]) File() string {
+	v.resolve()
+	return v.file
+}
+
+// Origin returns a human-readable description of where the variable's value is
+// defined, such as the name of a dotenv file and a line number.
+//
+// It returns an empty string if the value was not obtained from the environment
+// or if the source of the environment does not describe the value's origin.
+func (v *OfType[T]) Origin() string {
+	v.resolve()
+	return v.origin
+}
+
+// Alias returns the alias from which the variable's value was obtained.
+//
+// It returns an empty string if the value was not obtained from one of the
+// variable's aliases.
+func (v *OfType[T]) Alias() string {
+	v.resolve()
+	return v.alias
+}
+
+// Fallback returns the name of the variable from which the variable's value was
+// obtained, as per [AddFallback].
+//
+// It returns an empty string if the value was not obtained from a fallback
+// variable.
+func (v *OfType[T]) Fallback() string {
+	v.resolve()
+	return v.fallback
+}
+
 // Value returns the variable's value.
 //
 // If no value is available it returns a zero-value. It is the caller's
@@ -102,68 +178,292 @@ func (v *OfType[T]) NativeValue() T {
 	return v.value.native
 }
 
+func (v *OfType[T]) nativeValue() any {
+	return v.NativeValue()
+}
+
 // Error returns an error describing the variable's state.
 //
 // If the variable's availability is AvailabilityInvalid the error is guaranteed
-// to be a ValueError.
+// to be a ValueError, unless the variable's source is SourceFile, in which case
+// it may instead be a FileError.
 //
 // The error is nil if the variable is in a valid state, which occurs when it
 // has an availability of AvailabilityOK, or if it has an availability of
-// AvailabilityNone and v.Spec().IsRequired() is false.
+// AvailabilityNone and the variable is not required, either because
+// v.Spec().IsRequired() is false or because none of its conditional
+// requirements are satisfied.
 func (v *OfType[T]) Error() Error {
 	v.resolve()
 	return v.err
 }
 
+// In returns v as it is resolved within s.
+//
+// If s is nil, it returns v itself.
+func (v *OfType[T]) In(s *Scope) *OfType[T] {
+	if s == nil || s == v.scope {
+		return v
+	}
+
+	if x, ok := s.vars[v]; ok {
+		return x.(*OfType[T])
+	}
+
+	x := &OfType[T]{
+		spec:       v.spec,
+		registries: v.registries,
+		fallbacks:  v.fallbacks,
+		scope:      s,
+		lookup:     s.lookup,
+	}
+
+	s.vars[v] = x
+
+	return x
+}
+
+func (v *OfType[T]) in(s *Scope) Any {
+	return v.In(s)
+}
+
 func (v *OfType[T]) resolve() {
-	v.once.Do(func() {
-		// Override the availability to AvailabilityIgnored if any of the
-		// preconditions fail.
-		defer func() {
-			for _, fn := range v.spec.preconditions {
-				if !fn() {
-					v.availability = AvailabilityIgnored
-					break
-				}
+	v.m.Lock()
+	defer v.m.Unlock()
+
+	if !v.resolved {
+		v.resolved = true
+		v.resolveValue()
+	}
+}
+
+// reset discards the variable's resolved value, such that it is resolved again
+// the next time it is used.
+//
+// lookup is used as the source of the variable's value if none of its
+// registries has its own Lookup. If it is nil, the value is obtained from the
+// process's environment.
+func (v *OfType[T]) reset(lookup environment.Lookup) {
+	v.m.Lock()
+	defer v.m.Unlock()
+
+	v.resolved = false
+	v.lookup = lookup
+	v.availability = AvailabilityNone
+	v.source = SourceNone
+	v.file = ""
+	v.origin = ""
+	v.alias = ""
+	v.fallback = ""
+	v.value = valueOf[T]{}
+	v.err = nil
+}
+
+// resolveValue resolves the variable's value from its environment.
+func (v *OfType[T]) resolveValue() {
+	// Override the availability to AvailabilityIgnored if any of the
+	// preconditions fail.
+	defer func() {
+		for _, fn := range v.spec.preconditions {
+			if !fn(v.scope) {
+				v.availability = AvailabilityIgnored
+				break
 			}
-		}()
+		}
+	}()
+
+	env := v.environment()
+	name := v.spec.name
+	lit := Literal{}
+	lit.String, _ = env.Lookup(name)
+	source := SourceEnvironment
 
-		lit := Literal{
-			String: environment.Get(v.spec.name),
+	// Fall back to the aliases, in order, if the variable itself is
+	// undefined. Any other aliases that are defined must agree with the
+	// value that is used.
+	for _, alias := range v.spec.aliases {
+		value, _ := env.Lookup(alias)
+		if value == "" {
+			continue
 		}
 
 		if lit.String == "" {
-			if def, ok := v.spec.def.Get(); ok {
-				v.availability = AvailabilityOK
-				v.source = SourceDefault
-				v.value = def
-			} else if v.spec.required {
-				v.availability = AvailabilityNone
-				v.err = undefinedError{v.spec.Name()}
+			name = alias
+			lit.String = value
+			v.alias = alias
+		} else if value != lit.String {
+			v.availability = AvailabilityInvalid
+			v.source = SourceEnvironment
+			v.err = valueError{
+				name:    v.spec.name,
+				literal: lit,
+				cause:   fmt.Errorf("conflicts with the value of %s", alias),
 			}
 			return
 		}
+	}
+
+	if name, ok := v.FileVariableName(); ok {
+		if path, _ := env.Lookup(name); path != "" {
+			if lit.String != "" {
+				v.availability = AvailabilityInvalid
+				v.source = SourceEnvironment
+				v.err = valueError{
+					name:    v.spec.name,
+					literal: lit,
+					cause:   fmt.Errorf("must not be defined at the same time as %s", name),
+				}
+				return
+			}
+
+			data, err := os.ReadFile(path)
+			if err != nil {
+				v.availability = AvailabilityInvalid
+				v.source = SourceFile
+				v.file = path
+				v.err = fileError{v.spec.name, name, path, err}
+				return
+			}
+
+			// Files are typically written with a trailing newline that is
+			// not considered part of the value.
+			lit.String = strings.TrimSuffix(string(data), "\n")
+			lit.String = strings.TrimSuffix(lit.String, "\r")
+
+			if lit.String != "" {
+				source = SourceFile
+				v.file = path
+			}
+		}
+	}
 
-		v.source = SourceEnvironment
+	if lit.String == "" {
+		if v.resolveFallback() {
+			return
+		}
 
-		n, c, err := v.spec.Unmarshal(lit)
+		if def, ok := v.spec.def.Get(); ok {
+			v.availability = AvailabilityOK
+			v.source = SourceDefault
+			v.value = def
+		} else if v.isRequired() {
+			v.availability = AvailabilityNone
+			v.err = undefinedError{v.spec.Name()}
+		}
+		return
+	}
+
+	v.source = source
+
+	if source == SourceEnvironment {
+		if env, ok := env.(environment.OriginLookup); ok {
+			v.origin, _ = env.Origin(name)
+		}
+	}
+
+	n, c, err := v.spec.Unmarshal(lit)
+	if err != nil {
+		v.availability = AvailabilityInvalid
+		v.err = valueError{
+			name:    v.spec.name,
+			literal: lit,
+			cause:   err,
+		}
+		return
+	}
+
+	v.availability = AvailabilityOK
+	v.value = valueOf[T]{
+		verbatim:  lit,
+		native:    n,
+		canonical: c,
+	}
+}
+
+// isRequired returns true if the variable must have a value, either because
+// it is marked as required or because one of its requirements is satisfied.
+func (v *OfType[T]) isRequired() bool {
+	if v.spec.required {
+		return true
+	}
+
+	for _, fn := range v.spec.requirements {
+		if fn(v.scope) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// resolveFallback resolves the variable's value from its fallback variables. It
+// returns false if none of the fallback variables has a value.
+func (v *OfType[T]) resolveFallback() bool {
+	for _, fb := range v.fallbacks {
+		src := In(fb.source, v.scope)
+
+		if src.Availability() != AvailabilityOK {
+			continue
+		}
+
+		switch src.Source() {
+		case SourceEnvironment, SourceFile:
+		default:
+			continue
+		}
+
+		native := src.(interface{ nativeValue() any }).nativeValue()
+		if fb.convert != nil {
+			native = fb.convert(native)
+		}
+
+		v.source = src.Source()
+		v.file = src.File()
+		v.origin = src.Origin()
+		v.fallback = src.Spec().Name()
+
+		n := native.(T)
+		lit, err := v.spec.Marshal(n)
 		if err != nil {
 			v.availability = AvailabilityInvalid
 			v.err = valueError{
 				name:    v.spec.name,
-				literal: lit,
+				literal: src.Value().Verbatim(),
 				cause:   err,
 			}
-			return
+			return true
 		}
 
 		v.availability = AvailabilityOK
 		v.value = valueOf[T]{
 			verbatim:  lit,
 			native:    n,
-			canonical: c,
+			canonical: lit,
+		}
+
+		return true
+	}
+
+	return false
+}
+
+// environment returns the source of the variable's value.
+//
+// It returns the lookup of the first of the variable's registries that has one,
+// falling back to the lookup of the variable's scope or the lookup passed to the
+// most recent call to reset(), if any, or the process's environment.
+func (v *OfType[T]) environment() environment.Lookup {
+	for _, reg := range v.registries {
+		if reg.Lookup != nil {
+			return reg.Lookup
 		}
-	})
+	}
+
+	if v.lookup != nil {
+		return v.lookup
+	}
+
+	return environment.Process{}
 }
 
 // undefinedError is an Error that indicates that a variable is undefined and
@@ -182,3 +482,67 @@ func (e undefinedError) Error() string {
 		e.name,
 	)
 }
+
+// FileError indicates that a variable's value could not be read from the file
+// specified by its "_FILE" variable.
+type FileError interface {
+	Error
+
+	// Path returns the path of the file.
+	Path() string
+
+	// Unwrap returns the underlying cause of the error.
+	Unwrap() error
+}
+
+// fileError is an implementation of FileError.
+type fileError struct {
+	name, fileVar, path string
+	cause               error
+}
+
+func (e fileError) Name() string {
+	return e.name
+}
+
+func (e fileError) Path() string {
+	return e.path
+}
+
+func (e fileError) Unwrap() error {
+	return e.cause
+}
+
+func (e fileError) Error() string {
+	return fmt.Sprintf(
+		"unable to read %s from the file specified by %s: %s",
+		e.name,
+		e.fileVar,
+		e.cause,
+	)
+}
+
+// fallback is a variable from which another variable's value is obtained when
+// that variable is undefined.
+type fallback struct {
+	source  Any
+	convert func(any) any
+}
+
+// AddFallback configures dst to obtain its value from src if dst is undefined
+// and src is explicitly defined with a valid value.
+//
+// convert converts src's native value to dst's native type. If it is nil,
+// src's native value is used as-is, in which case both variables must have the
+// same native type.
+func AddFallback(dst, src Any, convert func(any) any) {
+	type fallbackTarget interface {
+		addFallback(fallback)
+	}
+
+	dst.(fallbackTarget).addFallback(fallback{src, convert})
+}
+
+func (v *OfType[T]) addFallback(fb fallback) {
+	v.fallbacks = append(v.fallbacks, fb)
+}
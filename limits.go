@@ -0,0 +1,90 @@
+package ferrite
+
+import (
+	"fmt"
+
+	"github.com/dogmatiq/ferrite/internal/maybe"
+	"github.com/dogmatiq/ferrite/internal/variable"
+)
+
+// rangeLimits is the minimum and maximum acceptable values of a variable whose
+// values are ordered, but are not represented using a numeric schema, such as
+// times and byte sizes.
+type rangeLimits[T any] struct {
+	Min, Max maybe.Value[T]
+
+	// Less returns true if a is less than b.
+	Less func(a, b T) bool
+
+	// Format returns the human-readable representation of v.
+	Format func(v T) string
+
+	// Lower and Higher are the words used to compare a value to a limit, such
+	// as "less" and "greater", or "earlier" and "later".
+	Lower, Higher string
+
+	// TooLow and TooHigh are the words used to describe a value that is
+	// outside the limits, such as "low" and "high", or "early" and "late".
+	TooLow, TooHigh string
+}
+
+// Check returns an error if the maximum is less than the minimum.
+func (l rangeLimits[T]) Check() error {
+	min, hasMin := l.Min.Get()
+	max, hasMax := l.Max.Get()
+
+	if hasMin && hasMax && l.Less(max, min) {
+		return fmt.Errorf("maximum must not be %s than the minimum", l.Lower)
+	}
+
+	return nil
+}
+
+// Constrain adds a constraint to b that requires its value to be within the
+// limits. It does nothing if neither limit is set.
+func (l rangeLimits[T]) Constrain(b *variable.TypedSpecBuilder[T]) {
+	min, hasMin := l.Min.Get()
+	max, hasMax := l.Max.Get()
+
+	if hasMin && hasMax {
+		b.BuiltInConstraint(
+			fmt.Sprintf("**MUST** be between `%s` and `%s`", l.Format(min), l.Format(max)),
+			l.check,
+		)
+	} else if hasMin {
+		b.BuiltInConstraint(
+			fmt.Sprintf("**MUST** be `%s` or %s", l.Format(min), l.Higher),
+			l.check,
+		)
+	} else if hasMax {
+		b.BuiltInConstraint(
+			fmt.Sprintf("**MUST** be `%s` or %s", l.Format(max), l.Lower),
+			l.check,
+		)
+	}
+}
+
+// check returns an error if v is outside the limits.
+func (l rangeLimits[T]) check(v T) variable.ConstraintError {
+	min, hasMin := l.Min.Get()
+	max, hasMax := l.Max.Get()
+
+	explain := func() string {
+		if hasMin && hasMax {
+			return fmt.Sprintf("expected between %s and %s", l.Format(min), l.Format(max))
+		} else if hasMin {
+			return fmt.Sprintf("expected %s or %s", l.Format(min), l.Higher)
+		}
+		return fmt.Sprintf("expected %s or %s", l.Format(max), l.Lower)
+	}
+
+	if hasMin && l.Less(v, min) {
+		return fmt.Errorf("too %s, %s", l.TooLow, explain())
+	}
+
+	if hasMax && l.Less(max, v) {
+		return fmt.Errorf("too %s, %s", l.TooHigh, explain())
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package ferrite
+
+import (
+	"github.com/dogmatiq/ferrite/internal/environment"
+	"github.com/dogmatiq/ferrite/internal/variable"
+)
+
+// Lookup is a source of environment variable values.
+//
+// Use the [WithLookup] option to configure a registry or [Init] call to use a
+// specific source.
+type Lookup interface {
+	// Lookup returns the value of the environment variable with the given
+	// name. ok is false if the variable is not defined.
+	Lookup(name string) (value string, ok bool)
+}
+
+// EnvironmentLookup returns a Lookup that obtains values from the process's
+// environment.
+//
+// This is the source used when no other Lookup is configured.
+func EnvironmentLookup() Lookup {
+	return environment.Process{}
+}
+
+// MapLookup returns a Lookup that obtains values from m, a map of variable name
+// to value.
+func MapLookup(m map[string]string) Lookup {
+	return environment.Map(m)
+}
+
+// ChainLookup returns a Lookup that obtains values from a sequence of other
+// lookups.
+//
+// Each variable's value is obtained from the first lookup in the sequence that
+// defines the variable, such that earlier lookups take precedence over later
+// ones.
+func ChainLookup(lookups ...Lookup) Lookup {
+	chain := make(environment.Chain, len(lookups))
+	for i, l := range lookups {
+		if l == nil {
+			panic("lookup must not be nil")
+		}
+		chain[i] = l
+	}
+	return chain
+}
+
+// WithLookup is an option that sets the source of environment variable values.
+//
+// When used as a registry option it applies to every variable in the registry.
+// When used as an option to [Init] or [Validate] it applies to every registry
+// that does not already have its own Lookup, including the default registry.
+//
+// [Init] configures the application as a whole, so the variables continue to
+// obtain their values from l after it returns, until Init is called again.
+// [Validate] only uses l to produce its report; the values that the variables
+// return to the application are left unchanged.
+func WithLookup(l Lookup) interface {
+	InitOption
+	RegistryOption
+} {
+	if l == nil {
+		panic("lookup must not be nil")
+	}
+
+	return option{
+		ApplyToInitConfig: func(cfg *initConfig) {
+			cfg.Lookup = l
+		},
+		ApplyToRegistry: func(reg *variable.Registry) {
+			reg.Lookup = l
+		},
+	}
+}
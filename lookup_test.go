@@ -0,0 +1,245 @@
+package ferrite_test
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite"
+	"github.com/dogmatiq/ferrite/internal/mode"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("type Lookup", func() {
+	AfterEach(func() {
+		tearDown()
+	})
+
+	Describe("func EnvironmentLookup()", func() {
+		It("returns values from the process's environment", func() {
+			os.Setenv("FERRITE_STRING", "<value>")
+
+			v, ok := EnvironmentLookup().Lookup("FERRITE_STRING")
+			Expect(ok).To(BeTrue())
+			Expect(v).To(Equal("<value>"))
+
+			_, ok = EnvironmentLookup().Lookup("FERRITE_UNDEFINED")
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	Describe("func MapLookup()", func() {
+		It("returns values from the map", func() {
+			l := MapLookup(map[string]string{
+				"FERRITE_STRING": "<value>",
+			})
+
+			v, ok := l.Lookup("FERRITE_STRING")
+			Expect(ok).To(BeTrue())
+			Expect(v).To(Equal("<value>"))
+
+			_, ok = l.Lookup("FERRITE_UNDEFINED")
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	Describe("func ChainLookup()", func() {
+		It("returns the value from the first lookup that defines the variable", func() {
+			l := ChainLookup(
+				MapLookup(map[string]string{
+					"FERRITE_A": "<first>",
+				}),
+				MapLookup(map[string]string{
+					"FERRITE_A": "<second>",
+					"FERRITE_B": "<second>",
+				}),
+			)
+
+			v, ok := l.Lookup("FERRITE_A")
+			Expect(ok).To(BeTrue())
+			Expect(v).To(Equal("<first>"))
+
+			v, ok = l.Lookup("FERRITE_B")
+			Expect(ok).To(BeTrue())
+			Expect(v).To(Equal("<second>"))
+
+			_, ok = l.Lookup("FERRITE_C")
+			Expect(ok).To(BeFalse())
+		})
+
+		It("panics if one of the lookups is nil", func() {
+			Expect(func() {
+				ChainLookup(nil)
+			}).To(PanicWith("lookup must not be nil"))
+		})
+	})
+})
+
+var _ = Describe("func WithLookup()", func() {
+	AfterEach(func() {
+		tearDown()
+	})
+
+	It("panics if the lookup is nil", func() {
+		Expect(func() {
+			WithLookup(nil)
+		}).To(PanicWith("lookup must not be nil"))
+	})
+
+	When("used as a registry option", func() {
+		It("obtains values of the registry's variables from the lookup", func() {
+			os.Setenv("FERRITE_STRING", "<environment>")
+
+			reg := NewRegistry(
+				"<key>",
+				"<name>",
+				WithLookup(MapLookup(map[string]string{
+					"FERRITE_STRING": "<map>",
+				})),
+			)
+
+			v := String("FERRITE_STRING", "<desc>").
+				Required(WithRegistry(reg))
+
+			Expect(v.Value()).To(Equal("<map>"))
+		})
+
+		It("does not fall back to the process's environment", func() {
+			os.Setenv("FERRITE_STRING", "<environment>")
+
+			reg := NewRegistry(
+				"<key>",
+				"<name>",
+				WithLookup(MapLookup(nil)),
+			)
+
+			v := String("FERRITE_STRING", "<desc>").
+				Optional(WithRegistry(reg))
+
+			_, ok := v.Value()
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	When("used as a Validate() option", func() {
+		It("obtains values of variables in the default registry from the lookup", func() {
+			String("FERRITE_STRING", "<desc>").
+				Required()
+
+			report, err := Validate(
+				WithLookup(MapLookup(map[string]string{
+					"FERRITE_STRING": "<map>",
+				})),
+			)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(report.Variables[0].Source).To(Equal(SourceEnvironment))
+
+			_, err = Validate()
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+
+	When("used as an Init() option", func() {
+		It("obtains values of variables in the default registry from the lookup", func() {
+			v := String("FERRITE_STRING", "<desc>").
+				Required()
+
+			Init(
+				WithLookup(MapLookup(map[string]string{
+					"FERRITE_STRING": "<map>",
+				})),
+			)
+
+			Expect(v.Value()).To(Equal("<map>"))
+		})
+
+		It("does not override the lookup of a registry that has its own", func() {
+			reg := NewRegistry(
+				"<key>",
+				"<name>",
+				WithLookup(MapLookup(map[string]string{
+					"FERRITE_STRING": "<registry>",
+				})),
+			)
+
+			v := String("FERRITE_STRING", "<desc>").
+				Required(WithRegistry(reg))
+
+			Init(
+				WithRegistry(reg),
+				WithLookup(MapLookup(map[string]string{
+					"FERRITE_STRING": "<init>",
+				})),
+			)
+
+			Expect(v.Value()).To(Equal("<registry>"))
+		})
+
+		It("does not affect subsequent calls without the option", func() {
+			os.Setenv("FERRITE_STRING", "<environment>")
+
+			v := String("FERRITE_STRING", "<desc>").
+				Required()
+
+			Init(
+				WithLookup(MapLookup(map[string]string{
+					"FERRITE_STRING": "<map>",
+				})),
+			)
+			Expect(v.Value()).To(Equal("<map>"))
+
+			Init()
+			Expect(v.Value()).To(Equal("<environment>"))
+		})
+
+		It("does not obtain the mode from the lookup", func() {
+			String("FERRITE_STRING", "<desc>").
+				Required()
+
+			exited := false
+			mode.DefaultConfig.Exit = func(int) {
+				exited = true
+			}
+			mode.DefaultConfig.Out = GinkgoWriter
+			mode.DefaultConfig.Err = GinkgoWriter
+
+			Init(
+				WithLookup(MapLookup(map[string]string{
+					"FERRITE_MODE":   "export/dotenv",
+					"FERRITE_STRING": "<value>",
+				})),
+			)
+
+			Expect(exited).To(BeFalse())
+		})
+	})
+})
+
+func ExampleWithLookup() {
+	defer example()()
+
+	v := ferrite.
+		String("FERRITE_STRING", "example string variable").
+		Required()
+
+	os.Setenv("FERRITE_STRING", "<environment>")
+
+	// Obtain values from a map, falling back to the process's environment for
+	// any variables that are not defined in the map.
+	ferrite.Init(
+		ferrite.WithLookup(
+			ferrite.ChainLookup(
+				ferrite.MapLookup(map[string]string{
+					"FERRITE_STRING": "<map>",
+				}),
+				ferrite.EnvironmentLookup(),
+			),
+		),
+	)
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// value is <map>
+}
@@ -0,0 +1,97 @@
+package ferrite_test
+
+import (
+	"os"
+	"time"
+
+	"github.com/dogmatiq/ferrite"
+)
+
+func ExampleInit_exportComposeFile() {
+	defer example()()
+
+	os.Setenv("FERRITE_BOOL", "true")
+	ferrite.
+		Bool("FERRITE_BOOL", "example bool").
+		Required()
+
+	os.Setenv("FERRITE_DURATION", "620s")
+	ferrite.
+		Duration("FERRITE_DURATION", "example duration").
+		WithDefault(1 * time.Hour).
+		Required()
+
+	ferrite.
+		Enum("FERRITE_ENUM", "example enum").
+		WithMembers("foo", "bar", "baz").
+		WithDefault("bar").
+		Required()
+
+	ferrite.
+		String("FERRITE_STRING", "example string").
+		Optional()
+
+	ferrite.
+		String("FERRITE_STRING_DEPRECATED", "example deprecated string").
+		Deprecated()
+
+	ferrite.
+		String("FERRITE_STRING_PRICE", "example string containing a dollar sign").
+		WithDefault("$5").
+		Required()
+
+	ferrite.
+		String("FERRITE_STRING_TEMPLATE", "example string containing braces").
+		WithDefault("{{.Name}}").
+		Required()
+
+	os.Setenv("FERRITE_STRING_SENSITIVE", "hunter2")
+	ferrite.
+		String("FERRITE_STRING_SENSITIVE", "example sensitive string").
+		WithDefault("password").
+		WithSensitiveContent().
+		Required()
+
+	os.Setenv("FERRITE_SVC_SERVICE_HOST", "host.example.org")
+	os.Setenv("FERRITE_SVC_SERVICE_PORT", "443")
+	ferrite.
+		KubernetesService("ferrite-svc").
+		Required()
+
+	os.Setenv("FERRITE_URL", "https//example.org")
+	ferrite.
+		URL("FERRITE_URL", "example URL").
+		Required()
+
+	// Tell ferrite to export a Docker Compose file containing the environment
+	// variables.
+	os.Setenv("FERRITE_MODE", "export/compose")
+
+	ferrite.Init()
+
+	// Output:
+	// services:
+	//   ferrite.test:
+	//     environment:
+	//       # example bool (required)
+	//       FERRITE_BOOL: "true"
+	//       # example duration (default: 1h)
+	//       FERRITE_DURATION: 620s
+	//       # example enum (default: bar)
+	//       FERRITE_ENUM: ${FERRITE_ENUM:-bar}
+	//       # example string (optional)
+	//       FERRITE_STRING: ${FERRITE_STRING:-}
+	//       # example string containing a dollar sign (default: '$5')
+	//       FERRITE_STRING_PRICE: ${FERRITE_STRING_PRICE:-$$5}
+	//       # example sensitive string (default: ********, sensitive)
+	//       FERRITE_STRING_SENSITIVE: ${FERRITE_STRING_SENSITIVE:-}
+	//       # example string containing braces (default: '{{.Name}}')
+	//       FERRITE_STRING_TEMPLATE: ${FERRITE_STRING_TEMPLATE:-}
+	//       # kubernetes "ferrite-svc" service host (required)
+	//       FERRITE_SVC_SERVICE_HOST: host.example.org
+	//       # kubernetes "ferrite-svc" service port (required)
+	//       FERRITE_SVC_SERVICE_PORT: "443"
+	//       # example URL (required)
+	//       FERRITE_URL: ${FERRITE_URL:?required} # https//example.org is invalid: URL must have a scheme
+	// <process exited successfully>
+}
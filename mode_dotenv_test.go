@@ -128,3 +128,41 @@ func ExampleInit_exportDotEnvFile() {
 	// export FERRITE_URL= # https//example.org is invalid: URL must have a scheme
 	// <process exited successfully>
 }
+
+func ExampleInit_exportDotEnvFileWithFiles() {
+	defer example()()
+
+	os.Setenv("FERRITE_STRING_FILE", "testdata/hello.txt")
+	ferrite.
+		String("FERRITE_STRING", "example string").
+		Required(ferrite.WithFileSuffix())
+
+	os.Setenv("FERRITE_NUM_UNSIGNED_FILE", "testdata/hello.txt")
+	ferrite.
+		Unsigned[uint16]("FERRITE_NUM_UNSIGNED", "example unsigned integer").
+		Required(ferrite.WithFileSuffix())
+
+	os.Setenv("FERRITE_STRING_MISSING_FILE", "testdata/missing.txt")
+	ferrite.
+		String("FERRITE_STRING_MISSING", "example string with a missing file").
+		Optional(ferrite.WithFileSuffix())
+
+	// Tell ferrite to export an env file containing the environment variables.
+	os.Setenv("FERRITE_MODE", "export/dotenv")
+
+	ferrite.Init()
+
+	// Output:
+	// # example unsigned integer (required)
+	// export FERRITE_NUM_UNSIGNED=
+	// export FERRITE_NUM_UNSIGNED_FILE=testdata/hello.txt # file content is invalid: unrecognized uint16 syntax
+	//
+	// # example string (required)
+	// export FERRITE_STRING=
+	// export FERRITE_STRING_FILE=testdata/hello.txt
+	//
+	// # example string with a missing file (optional)
+	// export FERRITE_STRING_MISSING=
+	// export FERRITE_STRING_MISSING_FILE=testdata/missing.txt # unable to read file: open testdata/missing.txt: no such file or directory
+	// <process exited successfully>
+}
@@ -0,0 +1,128 @@
+package ferrite_test
+
+import (
+	"os"
+	"time"
+
+	"github.com/dogmatiq/ferrite"
+)
+
+func ExampleInit_exportJSONSchema() {
+	defer example()()
+
+	ferrite.
+		Bool("FERRITE_BOOL", "example bool").
+		Required()
+
+	ferrite.
+		Duration("FERRITE_DURATION", "example duration").
+		WithDefault(1 * time.Hour).
+		Required()
+
+	ferrite.
+		Enum("FERRITE_ENUM", "example enum").
+		WithMembers("foo", "bar", "baz").
+		WithDefault("bar").
+		Required()
+
+	ferrite.
+		Signed[int]("FERRITE_NUM_SIGNED", "example signed integer").
+		WithMinimum(-5).
+		WithMaximum(10).
+		Required()
+
+	ferrite.
+		Unsigned[uint16]("FERRITE_NUM_UNSIGNED", "example unsigned integer").
+		WithDefault(8080).
+		Optional()
+
+	ferrite.
+		String("FERRITE_STRING", "example string").
+		WithMinimumLength(2).
+		WithMaximumLength(10).
+		WithPattern(`^[a-z]+$`).
+		Optional()
+
+	ferrite.
+		String("FERRITE_STRING_DEPRECATED", "example deprecated string").
+		Deprecated()
+
+	ferrite.
+		String("FERRITE_STRING_SENSITIVE", "example sensitive string").
+		WithDefault("password").
+		WithSensitiveContent().
+		Required()
+
+	// Tell ferrite to export a JSON Schema document describing the environment
+	// variables.
+	os.Setenv("FERRITE_MODE", "export/json-schema")
+
+	ferrite.Init()
+
+	// Output:
+	// {
+	//   "$schema": "https://json-schema.org/draft/2020-12/schema",
+	//   "title": "ferrite.test",
+	//   "type": "object",
+	//   "properties": {
+	//     "FERRITE_BOOL": {
+	//       "description": "example bool",
+	//       "type": "string",
+	//       "enum": [
+	//         "true",
+	//         "false"
+	//       ]
+	//     },
+	//     "FERRITE_DURATION": {
+	//       "description": "example duration",
+	//       "type": "string",
+	//       "default": "1h"
+	//     },
+	//     "FERRITE_ENUM": {
+	//       "description": "example enum",
+	//       "type": "string",
+	//       "enum": [
+	//         "foo",
+	//         "bar",
+	//         "baz"
+	//       ],
+	//       "default": "bar"
+	//     },
+	//     "FERRITE_NUM_SIGNED": {
+	//       "description": "example signed integer",
+	//       "type": "integer",
+	//       "minimum": -5,
+	//       "maximum": 10
+	//     },
+	//     "FERRITE_NUM_UNSIGNED": {
+	//       "description": "example unsigned integer",
+	//       "type": "integer",
+	//       "minimum": 0,
+	//       "maximum": 65535,
+	//       "default": 8080
+	//     },
+	//     "FERRITE_STRING": {
+	//       "description": "example string",
+	//       "type": "string",
+	//       "minLength": 2,
+	//       "maxLength": 10,
+	//       "pattern": "^[a-z]+$"
+	//     },
+	//     "FERRITE_STRING_DEPRECATED": {
+	//       "description": "example deprecated string",
+	//       "type": "string",
+	//       "deprecated": true
+	//     },
+	//     "FERRITE_STRING_SENSITIVE": {
+	//       "description": "example sensitive string",
+	//       "type": "string",
+	//       "writeOnly": true
+	//     }
+	//   },
+	//   "required": [
+	//     "FERRITE_BOOL",
+	//     "FERRITE_NUM_SIGNED"
+	//   ]
+	// }
+	// <process exited successfully>
+}
@@ -0,0 +1,101 @@
+package ferrite_test
+
+import (
+	"os"
+	"time"
+
+	"github.com/dogmatiq/ferrite"
+)
+
+func ExampleInit_exportKubernetesManifests() {
+	defer example()()
+
+	os.Setenv("FERRITE_BOOL", "true")
+	ferrite.
+		Bool("FERRITE_BOOL", "example bool").
+		Required()
+
+	os.Setenv("FERRITE_DURATION", "620s")
+	ferrite.
+		Duration("FERRITE_DURATION", "example duration").
+		WithDefault(1 * time.Hour).
+		Required()
+
+	ferrite.
+		Enum("FERRITE_ENUM", "example enum").
+		WithMembers("foo", "bar", "baz").
+		WithDefault("bar").
+		Required()
+
+	ferrite.
+		String("FERRITE_STRING", "example string").
+		Optional()
+
+	ferrite.
+		String("FERRITE_STRING_DEPRECATED", "example deprecated string").
+		Deprecated()
+
+	os.Setenv("FERRITE_STRING_SENSITIVE", "hunter2")
+	ferrite.
+		String("FERRITE_STRING_SENSITIVE", "example sensitive string").
+		WithDefault("password").
+		WithSensitiveContent().
+		Required()
+
+	os.Setenv("FERRITE_STRING_SENSITIVE_INVALID", "hunter2")
+	ferrite.
+		String("FERRITE_STRING_SENSITIVE_INVALID", "example invalid sensitive string").
+		WithMinimumLength(10).
+		WithSensitiveContent().
+		Required()
+
+	os.Setenv("FERRITE_SVC_SERVICE_HOST", "host.example.org")
+	os.Setenv("FERRITE_SVC_SERVICE_PORT", "443")
+	ferrite.
+		KubernetesService("ferrite-svc").
+		Required()
+
+	os.Setenv("FERRITE_URL", "https//example.org")
+	ferrite.
+		URL("FERRITE_URL", "example URL").
+		Required()
+
+	// Tell ferrite to export Kubernetes manifests containing the environment
+	// variables.
+	os.Setenv("FERRITE_MODE", "export/kubernetes")
+
+	ferrite.Init()
+
+	// Output:
+	// apiVersion: v1
+	// kind: ConfigMap
+	// metadata:
+	//   name: ferrite-test
+	// data:
+	//   # example bool (required)
+	//   FERRITE_BOOL: "true"
+	//   # example duration (default: 1h)
+	//   FERRITE_DURATION: 620s
+	//   # example URL (required)
+	//   FERRITE_URL: "" # https//example.org is invalid: URL must have a scheme
+	// ---
+	// apiVersion: v1
+	// kind: Secret
+	// metadata:
+	//   name: ferrite-test
+	// type: Opaque
+	// data:
+	//   # example sensitive string (default: ********, sensitive)
+	//   FERRITE_STRING_SENSITIVE: aHVudGVyMg==
+	//   # example invalid sensitive string (required, sensitive)
+	//   FERRITE_STRING_SENSITIVE_INVALID: "" # value is invalid: too short, expected length to be 10 bytes or more
+	//
+	// # To use these values, add the following to the container specification:
+	// #
+	// # envFrom:
+	// #   - configMapRef:
+	// #       name: ferrite-test
+	// #   - secretRef:
+	// #       name: ferrite-test
+	// <process exited successfully>
+}
@@ -0,0 +1,382 @@
+package ferrite_test
+
+import (
+	"os"
+
+	"github.com/dogmatiq/ferrite"
+)
+
+func ExampleInit_usageJSON() {
+	defer example()()
+
+	ferrite.
+		Bool("FERRITE_BOOL", "example bool").
+		Required()
+
+	ferrite.
+		Signed[int]("FERRITE_NUM", "example number").
+		WithMinimum(1).
+		WithMaximum(10).
+		WithDefault(5).
+		Required()
+
+	ferrite.
+		String("FERRITE_STRING", "example string").
+		WithSensitiveContent().
+		Optional()
+
+	// Tell ferrite to render a machine-readable description of the environment
+	// variables.
+	os.Setenv("FERRITE_MODE", "usage/json")
+
+	ferrite.Init()
+
+	// Output:
+	// {
+	//   "version": 1,
+	//   "app": "ferrite.test",
+	//   "registries": [],
+	//   "variables": [
+	//     {
+	//       "name": "FERRITE_BOOL",
+	//       "description": "example bool",
+	//       "required": true,
+	//       "sensitive": false,
+	//       "deprecated": false,
+	//       "defined_by_kubernetes": false,
+	//       "has_default": false,
+	//       "aliases": [],
+	//       "schema": {
+	//         "kind": "set",
+	//         "type": "bool",
+	//         "members": [
+	//           "true",
+	//           "false"
+	//         ]
+	//       },
+	//       "constraints": [],
+	//       "examples": [
+	//         {
+	//           "canonical": "true",
+	//           "normative": true,
+	//           "source": "schema"
+	//         },
+	//         {
+	//           "canonical": "false",
+	//           "normative": true,
+	//           "source": "schema"
+	//         }
+	//       ],
+	//       "documentation": [],
+	//       "relationships": {
+	//         "refers_to": [],
+	//         "depends_on": [],
+	//         "required_if": [],
+	//         "supersedes": [],
+	//         "superseded_by": []
+	//       }
+	//     },
+	//     {
+	//       "name": "FERRITE_NUM",
+	//       "description": "example number",
+	//       "required": true,
+	//       "sensitive": false,
+	//       "deprecated": false,
+	//       "defined_by_kubernetes": false,
+	//       "has_default": true,
+	//       "default": "+5",
+	//       "aliases": [],
+	//       "schema": {
+	//         "kind": "numeric",
+	//         "type": "int",
+	//         "min": "+1",
+	//         "max": "+10",
+	//         "bits": 64
+	//       },
+	//       "constraints": [],
+	//       "examples": [
+	//         {
+	//           "canonical": "+5",
+	//           "normative": true,
+	//           "source": "spec_default"
+	//         },
+	//         {
+	//           "canonical": "+1",
+	//           "description": "the minimum accepted value",
+	//           "normative": false,
+	//           "source": "schema"
+	//         },
+	//         {
+	//           "canonical": "+10",
+	//           "description": "the maximum accepted value",
+	//           "normative": false,
+	//           "source": "schema"
+	//         }
+	//       ],
+	//       "documentation": [
+	//         {
+	//           "summary": "Signed integer syntax",
+	//           "paragraphs": [
+	//             "Signed integers can only be specified using decimal notation. A leading positive sign (`+`) is **OPTIONAL**. A leading negative sign (`-`) is **REQUIRED** in order to specify a negative value.",
+	//             "Internally, the `FERRITE_NUM` variable is represented using a signed 64-bit integer type (`int`); any value that overflows this data-type is invalid."
+	//           ],
+	//           "important": false
+	//         }
+	//       ],
+	//       "relationships": {
+	//         "refers_to": [],
+	//         "depends_on": [],
+	//         "required_if": [],
+	//         "supersedes": [],
+	//         "superseded_by": []
+	//       }
+	//     },
+	//     {
+	//       "name": "FERRITE_STRING",
+	//       "description": "example string",
+	//       "required": false,
+	//       "sensitive": true,
+	//       "deprecated": false,
+	//       "defined_by_kubernetes": false,
+	//       "has_default": false,
+	//       "aliases": [],
+	//       "schema": {
+	//         "kind": "string",
+	//         "type": "string"
+	//       },
+	//       "constraints": [],
+	//       "examples": [
+	//         {
+	//           "canonical": "foo",
+	//           "normative": false,
+	//           "source": "schema"
+	//         }
+	//       ],
+	//       "documentation": [],
+	//       "relationships": {
+	//         "refers_to": [],
+	//         "depends_on": [],
+	//         "required_if": [],
+	//         "supersedes": [],
+	//         "superseded_by": []
+	//       }
+	//     }
+	//   ],
+	//   "groups": []
+	// }
+	// <process exited successfully>
+}
+
+func ExampleInit_usageJSONWithRequiredIf() {
+	defer example()()
+
+	mode := ferrite.
+		Enum("FERRITE_TLS_MODE", "example TLS mode").
+		WithMembers("auto", "manual").
+		WithDefault("auto").
+		Required()
+
+	ferrite.
+		String("FERRITE_TLS_CERT", "example TLS certificate").
+		Optional(
+			ferrite.RequiredIf(
+				mode,
+				func(m string) bool { return m == "manual" },
+				ferrite.WithConditionDescription("is manual"),
+			),
+		)
+
+	// Tell ferrite to render a machine-readable description of the environment
+	// variables.
+	os.Setenv("FERRITE_MODE", "usage/json")
+
+	ferrite.Init()
+
+	// Output:
+	// {
+	//   "version": 1,
+	//   "app": "ferrite.test",
+	//   "registries": [],
+	//   "variables": [
+	//     {
+	//       "name": "FERRITE_TLS_CERT",
+	//       "description": "example TLS certificate",
+	//       "required": false,
+	//       "sensitive": false,
+	//       "deprecated": false,
+	//       "defined_by_kubernetes": false,
+	//       "has_default": false,
+	//       "aliases": [],
+	//       "schema": {
+	//         "kind": "string",
+	//         "type": "string"
+	//       },
+	//       "constraints": [],
+	//       "examples": [
+	//         {
+	//           "canonical": "foo",
+	//           "normative": false,
+	//           "source": "schema"
+	//         }
+	//       ],
+	//       "documentation": [],
+	//       "relationships": {
+	//         "refers_to": [],
+	//         "depends_on": [],
+	//         "required_if": [
+	//           {
+	//             "variable": "FERRITE_TLS_MODE",
+	//             "condition": "is manual"
+	//           }
+	//         ],
+	//         "supersedes": [],
+	//         "superseded_by": []
+	//       }
+	//     },
+	//     {
+	//       "name": "FERRITE_TLS_MODE",
+	//       "description": "example TLS mode",
+	//       "required": true,
+	//       "sensitive": false,
+	//       "deprecated": false,
+	//       "defined_by_kubernetes": false,
+	//       "has_default": true,
+	//       "default": "auto",
+	//       "aliases": [],
+	//       "schema": {
+	//         "kind": "set",
+	//         "type": "string",
+	//         "members": [
+	//           "auto",
+	//           "manual"
+	//         ]
+	//       },
+	//       "constraints": [],
+	//       "examples": [
+	//         {
+	//           "canonical": "auto",
+	//           "normative": true,
+	//           "source": "schema"
+	//         },
+	//         {
+	//           "canonical": "manual",
+	//           "normative": true,
+	//           "source": "schema"
+	//         }
+	//       ],
+	//       "documentation": [],
+	//       "relationships": {
+	//         "refers_to": [],
+	//         "depends_on": [],
+	//         "required_if": [],
+	//         "supersedes": [],
+	//         "superseded_by": []
+	//       }
+	//     }
+	//   ],
+	//   "groups": []
+	// }
+	// <process exited successfully>
+}
+
+func ExampleInit_usageJSONWithGroup() {
+	defer example()()
+
+	ferrite.ExactlyOneOf(
+		ferrite.
+			String("FERRITE_API_KEY", "example API key").
+			Optional(),
+		ferrite.
+			String("FERRITE_API_TOKEN", "example API token").
+			Optional(),
+	)
+
+	// Tell ferrite to render a machine-readable description of the environment
+	// variables.
+	os.Setenv("FERRITE_MODE", "usage/json")
+
+	ferrite.Init()
+
+	// Output:
+	// {
+	//   "version": 1,
+	//   "app": "ferrite.test",
+	//   "registries": [],
+	//   "variables": [
+	//     {
+	//       "name": "FERRITE_API_KEY",
+	//       "description": "example API key",
+	//       "required": false,
+	//       "sensitive": false,
+	//       "deprecated": false,
+	//       "defined_by_kubernetes": false,
+	//       "has_default": false,
+	//       "aliases": [],
+	//       "schema": {
+	//         "kind": "string",
+	//         "type": "string"
+	//       },
+	//       "constraints": [],
+	//       "examples": [
+	//         {
+	//           "canonical": "foo",
+	//           "normative": false,
+	//           "source": "schema"
+	//         }
+	//       ],
+	//       "documentation": [],
+	//       "relationships": {
+	//         "refers_to": [],
+	//         "depends_on": [],
+	//         "required_if": [],
+	//         "supersedes": [],
+	//         "superseded_by": []
+	//       }
+	//     },
+	//     {
+	//       "name": "FERRITE_API_TOKEN",
+	//       "description": "example API token",
+	//       "required": false,
+	//       "sensitive": false,
+	//       "deprecated": false,
+	//       "defined_by_kubernetes": false,
+	//       "has_default": false,
+	//       "aliases": [],
+	//       "schema": {
+	//         "kind": "string",
+	//         "type": "string"
+	//       },
+	//       "constraints": [],
+	//       "examples": [
+	//         {
+	//           "canonical": "foo",
+	//           "normative": false,
+	//           "source": "schema"
+	//         }
+	//       ],
+	//       "documentation": [],
+	//       "relationships": {
+	//         "refers_to": [],
+	//         "depends_on": [],
+	//         "required_if": [],
+	//         "supersedes": [],
+	//         "superseded_by": []
+	//       }
+	//     }
+	//   ],
+	//   "groups": [
+	//     {
+	//       "constraint": "exactly_one",
+	//       "members": [
+	//         [
+	//           "FERRITE_API_KEY"
+	//         ],
+	//         [
+	//           "FERRITE_API_TOKEN"
+	//         ]
+	//       ]
+	//     }
+	//   ]
+	// }
+	// <process exited successfully>
+}
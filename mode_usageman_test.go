@@ -0,0 +1,70 @@
+package ferrite_test
+
+import (
+	"os"
+
+	"github.com/dogmatiq/ferrite"
+)
+
+func ExampleInit_usageManualPage() {
+	defer example()()
+
+	ferrite.
+		Bool("FERRITE_BOOL", "example bool").
+		Required()
+
+	ferrite.
+		Signed[int]("FERRITE_NUM", "example number").
+		WithMinimum(1).
+		WithMaximum(10).
+		WithDefault(5).
+		Required()
+
+	ferrite.
+		String("FERRITE_STRING", "example string").
+		WithSensitiveContent().
+		Optional()
+
+	// Tell ferrite to render the ENVIRONMENT section of a manual page.
+	os.Setenv("FERRITE_MODE", "usage/man")
+
+	ferrite.Init()
+
+	// Output:
+	// .SH ENVIRONMENT
+	// .TP
+	// .B FERRITE_BOOL
+	// example bool
+	// .IP
+	// The \fBFERRITE_BOOL\fR variable's value \fBMUST\fR be either \fBtrue\fR or \fBfalse\fR.
+	// .IP
+	// .nf
+	// export FERRITE_BOOL=true
+	// export FERRITE_BOOL=false
+	// .fi
+	// .TP
+	// .B FERRITE_NUM
+	// example number
+	// .IP
+	// The \fBFERRITE_NUM\fR variable \fBMAY\fR be left undefined, in which case the default value of \fB+5\fR is used. Otherwise, the value \fBMUST\fR be between \fB+1\fR and \fB+10\fR.
+	// .IP
+	// .nf
+	// export FERRITE_NUM=+5  # (default)
+	// export FERRITE_NUM=+1  # (non-normative) the minimum accepted value
+	// export FERRITE_NUM=+10 # (non-normative) the maximum accepted value
+	// .fi
+	// .IP
+	// .I Signed integer syntax
+	// .IP
+	// Signed integers can only be specified using decimal notation. A leading positive sign (\fB+\fR) is \fBOPTIONAL\fR. A leading negative sign (\fB\-\fR) is \fBREQUIRED\fR in order to specify a negative value.
+	// .IP
+	// Internally, the \fBFERRITE_NUM\fR variable is represented using a signed 64-bit integer type (\fBint\fR); any value that overflows this data-type is invalid.
+	// .TP
+	// .B FERRITE_STRING
+	// example string
+	// .IP
+	// The \fBFERRITE_STRING\fR variable \fBMAY\fR be left undefined.
+	// .IP
+	// This variable is \fBsensitive\fR; its value may contain private information.
+	// <process exited successfully>
+}
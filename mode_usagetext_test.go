@@ -0,0 +1,69 @@
+package ferrite_test
+
+import (
+	"os"
+
+	"github.com/dogmatiq/ferrite"
+)
+
+func ExampleInit_usageText() {
+	defer example()()
+
+	ferrite.
+		Bool("FERRITE_BOOL", "example bool").
+		Required()
+
+	ferrite.
+		Signed[int]("FERRITE_NUM", "example number").
+		WithMinimum(1).
+		WithMaximum(10).
+		WithDefault(5).
+		Required()
+
+	ferrite.
+		String("FERRITE_STRING", "example string").
+		WithSensitiveContent().
+		Optional()
+
+	// Tell ferrite to render plain text documentation about the environment
+	// variables.
+	os.Setenv("FERRITE_MODE", "usage/text")
+
+	ferrite.Init()
+
+	// Output:
+	// FERRITE_BOOL
+	//     example bool
+	//
+	//     The FERRITE_BOOL variable's value MUST be either true or false.
+	//
+	//       export FERRITE_BOOL=true
+	//       export FERRITE_BOOL=false
+	//
+	// FERRITE_NUM
+	//     example number
+	//
+	//     The FERRITE_NUM variable MAY be left undefined, in which case the default
+	//     value of +5 is used. Otherwise, the value MUST be between +1 and +10.
+	//
+	//       export FERRITE_NUM=+5  # (default)
+	//       export FERRITE_NUM=+1  # (non-normative) the minimum accepted value
+	//       export FERRITE_NUM=+10 # (non-normative) the maximum accepted value
+	//
+	//     Signed integer syntax:
+	//
+	//     Signed integers can only be specified using decimal notation. A leading
+	//     positive sign (+) is OPTIONAL. A leading negative sign (-) is REQUIRED in
+	//     order to specify a negative value.
+	//
+	//     Internally, the FERRITE_NUM variable is represented using a signed 64-bit
+	//     integer type (int); any value that overflows this data-type is invalid.
+	//
+	// FERRITE_STRING
+	//     example string
+	//
+	//     The FERRITE_STRING variable MAY be left undefined.
+	//
+	//     ⚠️ This variable is sensitive; its value may contain private information.
+	// <process exited successfully>
+}
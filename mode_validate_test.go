@@ -1,6 +1,7 @@
 package ferrite_test
 
 import (
+	"fmt"
 	"os"
 	"strings"
 	"time"
@@ -422,3 +423,130 @@ func ExampleInit_validationWithInvalidValues() {
 	//
 	// <process exited with error code 1>
 }
+
+func ExampleInit_validationWithConstraints() {
+	defer example()()
+
+	os.Setenv("FERRITE_DURATION", "90s")
+	ferrite.
+		Duration("FERRITE_DURATION", "example duration").
+		WithConstraintFunc(
+			"must be a whole number of minutes",
+			func(d time.Duration) error {
+				if d%time.Minute != 0 {
+					return fmt.Errorf("expected a whole number of minutes, got %s remainder", d%time.Minute)
+				}
+				return nil
+			},
+		).
+		Required()
+
+	os.Setenv("FERRITE_NUM_UNSIGNED", "3")
+	ferrite.
+		Unsigned[uint16]("FERRITE_NUM_UNSIGNED", "example unsigned integer").
+		WithConstraint(
+			"must be even",
+			func(n uint16) bool {
+				return n%2 == 0
+			},
+		).
+		Required()
+
+	ferrite.Init()
+
+	// Output:
+	// Environment Variables:
+	//
+	//  ❯ FERRITE_DURATION      example duration            1ns ...     ✗ set to 90s, expected a whole number of minutes, got 30s remainder
+	//  ❯ FERRITE_NUM_UNSIGNED  example unsigned integer    <uint16>    ✗ set to 3, must be even
+	//
+	// <process exited with error code 1>
+}
+
+func ExampleInit_validationWithSensitiveSlice() {
+	defer example()()
+
+	os.Setenv("FERRITE_SLICE_SENSITIVE", "secret1,123")
+	ferrite.
+		SliceOf[uint16]("FERRITE_SLICE_SENSITIVE", "example sensitive slice", ferrite.Unsigned[uint16]("FERRITE_SLICE_SENSITIVE", "example sensitive slice")).
+		WithSensitiveContent().
+		Required()
+
+	ferrite.Init()
+
+	// Output:
+	// Environment Variables:
+	//
+	//  ❯ FERRITE_SLICE_SENSITIVE  example sensitive slice    <uint16>, ...    ✗ set to ***********, element 1 (*******) is invalid, expected integer between 0 and 65535
+	//
+	// <process exited with error code 1>
+}
+
+func ExampleInit_validationWithSensitiveMap() {
+	defer example()()
+
+	os.Setenv("FERRITE_MAP_SENSITIVE", "user=hunter2x,admin=123")
+	ferrite.
+		MapOf[string, uint16]("FERRITE_MAP_SENSITIVE", "example sensitive map", ferrite.String("FERRITE_MAP_SENSITIVE", "example sensitive map"), ferrite.Unsigned[uint16]("FERRITE_MAP_SENSITIVE", "example sensitive map")).
+		WithSensitiveContent().
+		Required()
+
+	ferrite.Init()
+
+	// Output:
+	// Environment Variables:
+	//
+	//  ❯ FERRITE_MAP_SENSITIVE  example sensitive map    <string>=<uint16>, ...    ✗ set to ***********************, value of key **** (********) is invalid, expected integer between 0 and 65535
+	//
+	// <process exited with error code 1>
+}
+
+func ExampleInit_validationWithSensitiveMapEntry() {
+	defer example()()
+
+	os.Setenv("FERRITE_MAP_SENSITIVE", "user=1,hunter2secret")
+	ferrite.
+		MapOf[string, uint16]("FERRITE_MAP_SENSITIVE", "example sensitive map", ferrite.String("FERRITE_MAP_SENSITIVE", "example sensitive map"), ferrite.Unsigned[uint16]("FERRITE_MAP_SENSITIVE", "example sensitive map")).
+		WithSensitiveContent().
+		Required()
+
+	ferrite.Init()
+
+	// Output:
+	// Environment Variables:
+	//
+	//  ❯ FERRITE_MAP_SENSITIVE  example sensitive map    <string>=<uint16>, ...    ✗ set to ********************, entry 2 (*************) is invalid, expected a key and value separated by =
+	//
+	// <process exited with error code 1>
+}
+
+func ExampleInit_validationWithFiles() {
+	defer example()()
+
+	os.Setenv("FERRITE_STRING_FILE", "testdata/hello.txt")
+	ferrite.
+		String("FERRITE_STRING", "example string").
+		Required(ferrite.WithFileSuffix())
+
+	os.Setenv("FERRITE_STRING_MISSING_FILE", "testdata/missing.txt")
+	ferrite.
+		String("FERRITE_STRING_MISSING", "example string with a missing file").
+		Required(ferrite.WithFileSuffix())
+
+	os.Setenv("FERRITE_STRING_CONFLICT", "hello")
+	os.Setenv("FERRITE_STRING_CONFLICT_FILE", "testdata/hello.txt")
+	ferrite.
+		String("FERRITE_STRING_CONFLICT", "example string with conflicting values").
+		Required(ferrite.WithFileSuffix())
+
+	ferrite.Init()
+
+	// Output:
+	// Environment Variables:
+	//
+	//    FERRITE_STRING           example string                            <string>    ✓ set to 'Hello, world!' from testdata/hello.txt
+	//  ❯ FERRITE_STRING_CONFLICT  example string with conflicting values    <string>    ✗ set to hello, must not be defined at the same time as FERRITE_STRING_CONFLICT_FILE
+	//  ❯ FERRITE_STRING_MISSING   example string with a missing file        <string>    ✗ unable to read testdata/missing.txt, no such file or directory
+	//
+	// <process exited with error code 1>
+}
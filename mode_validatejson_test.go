@@ -0,0 +1,141 @@
+package ferrite_test
+
+import (
+	"os"
+	"time"
+
+	"github.com/dogmatiq/ferrite"
+)
+
+func ExampleInit_validateJSON() {
+	defer example()()
+
+	os.Setenv("FERRITE_DURATION", "620s")
+	ferrite.
+		Duration("FERRITE_DURATION", "example duration").
+		WithDefault(1 * time.Hour).
+		Required()
+
+	ferrite.
+		Enum("FERRITE_ENUM", "example enum").
+		WithMembers("foo", "bar", "baz").
+		WithDefault("bar").
+		Required()
+
+	os.Setenv("FERRITE_ENUM_INVALID", "qux")
+	ferrite.
+		Enum("FERRITE_ENUM_INVALID", "example enum with an invalid value").
+		WithMembers("foo", "bar", "baz").
+		Required()
+
+	os.Setenv("FERRITE_NUM_SIGNED", "-10")
+	ferrite.
+		Signed[int]("FERRITE_NUM_SIGNED", "example signed integer").
+		WithMinimum(0).
+		Required()
+
+	ferrite.
+		Unsigned[uint16]("FERRITE_NUM_UNSIGNED", "example unsigned integer").
+		Required()
+
+	os.Setenv("FERRITE_SLICE", "a,bb")
+	ferrite.
+		SliceOf[string]("FERRITE_SLICE", "example slice", ferrite.String("FERRITE_SLICE", "example slice").WithMinimumLength(2)).
+		Required()
+
+	os.Setenv("FERRITE_STRING_SENSITIVE", "hunter2")
+	ferrite.
+		String("FERRITE_STRING_SENSITIVE", "example sensitive string").
+		WithMinimumLength(10).
+		WithSensitiveContent().
+		Required()
+
+	// Tell ferrite to render the validation result as JSON.
+	os.Setenv("FERRITE_MODE", "validate/json")
+
+	ferrite.Init()
+
+	// Output:
+	// {"name":"FERRITE_DURATION","availability":"ok","source":"environment","sensitive":false,"value":"620s","canonical":"10m20s"}
+	// {"name":"FERRITE_ENUM","availability":"ok","source":"default","sensitive":false,"canonical":"bar"}
+	// {"name":"FERRITE_ENUM_INVALID","availability":"invalid","source":"environment","sensitive":false,"value":"qux","error":{"type":"set_membership","message":"expected foo, bar or baz","members":["foo","bar","baz"]}}
+	// {"name":"FERRITE_NUM_SIGNED","availability":"invalid","source":"environment","sensitive":false,"value":"-10","error":{"type":"min","message":"too low, expected +0 or greater","min":"+0"}}
+	// {"name":"FERRITE_NUM_UNSIGNED","availability":"none","source":"none","sensitive":false,"error":{"type":"undefined","message":"FERRITE_NUM_UNSIGNED is undefined and does not have a default value"}}
+	// {"name":"FERRITE_SLICE","availability":"invalid","source":"environment","sensitive":false,"value":"a,bb","error":{"type":"element","message":"element 1 (a) is invalid, too short, expected length to be 2 bytes or more","index":0,"cause":{"type":"min_length","message":"too short, expected length to be 2 bytes or more","min_length":2}}}
+	// {"name":"FERRITE_STRING_SENSITIVE","availability":"invalid","source":"environment","sensitive":true,"error":{"type":"min_length","message":"too short, expected length to be 10 bytes or more","min_length":10}}
+	// <process exited with error code 1>
+}
+
+func ExampleInit_validateJSONWithGroups() {
+	defer example()()
+
+	url := ferrite.
+		URL("FERRITE_DB_URL", "example database URL").
+		Optional()
+
+	host := ferrite.
+		String("FERRITE_DB_HOST", "example database host").
+		Optional()
+
+	ferrite.ExactlyOneOf(url, host)
+
+	os.Setenv("FERRITE_MODE", "validate/json")
+	ferrite.Init()
+
+	// Output:
+	// {"name":"FERRITE_DB_HOST","availability":"none","source":"none","sensitive":false}
+	// {"name":"FERRITE_DB_URL","availability":"none","source":"none","sensitive":false}
+	// {"group":"exactly_one_of","variables":["FERRITE_DB_URL","FERRITE_DB_HOST"],"error":{"type":"group","message":"FERRITE_DB_URL and FERRITE_DB_HOST are undefined, define exactly one of them"}}
+	// <process exited with error code 1>
+}
+
+func ExampleInit_validateJSONWithSensitiveSlice() {
+	defer example()()
+
+	os.Setenv("FERRITE_SLICE_SENSITIVE", "secret1,123")
+	ferrite.
+		SliceOf[uint16]("FERRITE_SLICE_SENSITIVE", "example sensitive slice", ferrite.Unsigned[uint16]("FERRITE_SLICE_SENSITIVE", "example sensitive slice")).
+		WithSensitiveContent().
+		Required()
+
+	os.Setenv("FERRITE_MODE", "validate/json")
+	ferrite.Init()
+
+	// Output:
+	// {"name":"FERRITE_SLICE_SENSITIVE","availability":"invalid","source":"environment","sensitive":true,"error":{"type":"element","message":"element 1 (*******) is invalid, expected integer between 0 and 65535","index":0,"cause":{"type":"invalid","message":"expected integer between 0 and 65535"}}}
+	// <process exited with error code 1>
+}
+
+func ExampleInit_validateJSONWithSensitiveMap() {
+	defer example()()
+
+	os.Setenv("FERRITE_MAP_SENSITIVE", "user=hunter2x,admin=123")
+	ferrite.
+		MapOf[string, uint16]("FERRITE_MAP_SENSITIVE", "example sensitive map", ferrite.String("FERRITE_MAP_SENSITIVE", "example sensitive map"), ferrite.Unsigned[uint16]("FERRITE_MAP_SENSITIVE", "example sensitive map")).
+		WithSensitiveContent().
+		Required()
+
+	os.Setenv("FERRITE_MODE", "validate/json")
+	ferrite.Init()
+
+	// Output:
+	// {"name":"FERRITE_MAP_SENSITIVE","availability":"invalid","source":"environment","sensitive":true,"error":{"type":"entry_value","message":"value of key **** (********) is invalid, expected integer between 0 and 65535","cause":{"type":"invalid","message":"expected integer between 0 and 65535"}}}
+	// <process exited with error code 1>
+}
+
+func ExampleInit_validateJSONWithSensitiveMapEntry() {
+	defer example()()
+
+	os.Setenv("FERRITE_MAP_SENSITIVE", "user=1,hunter2secret")
+	ferrite.
+		MapOf[string, uint16]("FERRITE_MAP_SENSITIVE", "example sensitive map", ferrite.String("FERRITE_MAP_SENSITIVE", "example sensitive map"), ferrite.Unsigned[uint16]("FERRITE_MAP_SENSITIVE", "example sensitive map")).
+		WithSensitiveContent().
+		Required()
+
+	os.Setenv("FERRITE_MODE", "validate/json")
+	ferrite.Init()
+
+	// Output:
+	// {"name":"FERRITE_MAP_SENSITIVE","availability":"invalid","source":"environment","sensitive":true,"error":{"type":"entry","message":"entry 2 (*************) is invalid, expected a key and value separated by =","index":1}}
+	// <process exited with error code 1>
+}
@@ -21,6 +21,10 @@ type option struct {
 
 	ApplyToRefersToRelationship   func(*variable.RefersTo)
 	ApplyToSupersedesRelationship func(*variable.Supersedes)
+	ApplyToRequiredIfRelationship func(*variable.RequiredIf)
+	ApplyToDependsOnRelationship  func(*variable.DependsOn)
+	ApplyToRelevantIfConfig       func(*relevantIfConfig)
+	ApplyToSupersededByConfig     func(*supersededByConfig)
 }
 
 func (o option) applyInitOption(cfg *initConfig) {
@@ -39,6 +43,14 @@ func (o option) applyRequiredOptionToSpec(b variable.SpecBuilder) {
 	applyOption(b, o.ApplyToSpec, o.ApplyToSpecInRequiredSet)
 }
 
+func (o option) applyStructOptionToConfig(cfg *variableSetConfig) {
+	applyOption(cfg, o.ApplyToSetConfig, o.ApplyToRequiredSetConfig)
+}
+
+func (o option) applyStructOptionToSpec(b variable.SpecBuilder) {
+	applyOption(b, o.ApplyToSpec, o.ApplyToSpecInRequiredSet)
+}
+
 func (o option) applyOptionalOptionToConfig(cfg *variableSetConfig) {
 	applyOption(cfg, o.ApplyToSetConfig, o.ApplyToOptionalSetConfig)
 }
@@ -63,6 +75,22 @@ func (o option) applySupersedesOption(r *variable.Supersedes) {
 	applyOption(r, o.ApplyToSupersedesRelationship)
 }
 
+func (o option) applyRequiredIfOption(r *variable.RequiredIf) {
+	applyOption(r, o.ApplyToRequiredIfRelationship)
+}
+
+func (o option) applyRelevantIfOptionToConfig(cfg *relevantIfConfig) {
+	applyOption(cfg, o.ApplyToRelevantIfConfig)
+}
+
+func (o option) applyRelevantIfOptionToRelationship(r *variable.DependsOn) {
+	applyOption(r, o.ApplyToDependsOnRelationship)
+}
+
+func (o option) applySupersededByOption(cfg *supersededByConfig) {
+	applyOption(cfg, o.ApplyToSupersededByConfig)
+}
+
 func applyOption[T any](cfg T, funcs ...func(T)) {
 	for _, fn := range funcs {
 		if fn != nil {
@@ -1,14 +1,19 @@
 package ferrite
 
 import (
+	"fmt"
 	"reflect"
 
+	"github.com/dogmatiq/ferrite/internal/reflectx"
 	"github.com/dogmatiq/ferrite/internal/variable"
 )
 
 // RelevantIf is an option that enables a variable set only if the value
 // obtained from another set, s, is "truthy" (not the zero-value).
 //
+// The WhenEqual() and WhenFunc() options can be used to enable the variable
+// set based on a specific condition instead.
+//
 // A "irrelevant" variable set behaves as though its environment variables are
 // undefined, irrespective of the actual values of the variables and any default
 // values.
@@ -17,33 +22,185 @@ func RelevantIf(s VariableSet, options ...RelevantIfOption) interface {
 	OptionalOption
 	DeprecatedOption
 } {
+	var cfg relevantIfConfig
+	for _, opt := range options {
+		opt.applyRelevantIfOptionToConfig(&cfg)
+	}
+
 	return option{
 		ApplyToSpec: func(b variable.SpecBuilder) {
+			subject := b.Peek()
+
+			if cfg.Predicate != nil {
+				checkPredicateType("RelevantIf", subject, s, cfg.PredicateType)
+			}
+
 			for _, v := range s.variables() {
+				rel := variable.DependsOn{
+					Subject:       subject,
+					DependsOn:     v.Spec(),
+					IsConditional: cfg.Predicate != nil,
+				}
+
+				if cfg.Describe != nil {
+					rel.Condition = cfg.Describe(v.Spec())
+				}
+
+				for _, opt := range options {
+					opt.applyRelevantIfOptionToRelationship(&rel)
+				}
+
 				variable.EstablishRelationships(
 					variable.RefersTo{
-						Subject:  b.Peek(),
+						Subject:  subject,
 						RefersTo: v.Spec(),
 					},
-					variable.DependsOn{
-						Subject:   b.Peek(),
-						DependsOn: v.Spec(),
-					},
-				)
-
-				b.Precondition(
-					func() bool {
-						return !reflect.ValueOf(
-							s.value(),
-						).IsZero()
-					},
+					rel,
 				)
 			}
+
+			b.Precondition(
+				func(sc *variable.Scope) bool {
+					v := s.value(sc)
+					if v == nil {
+						return false
+					}
+
+					if cfg.Predicate == nil {
+						return !reflect.ValueOf(v).IsZero()
+					}
+
+					return cfg.Predicate(subject, v)
+				},
+			)
 		},
 	}
 }
 
 // RelevantIfOption changes the behavior of the RelevantIf() option.
 type RelevantIfOption interface {
-	future()
+	applyRelevantIfOptionToConfig(*relevantIfConfig)
+	applyRelevantIfOptionToRelationship(*variable.DependsOn)
+}
+
+// relevantIfConfig is the configuration for the RelevantIf() option, built
+// from RelevantIfOption values.
+type relevantIfConfig struct {
+	// Predicate, if non-nil, is called with the value of the other variable
+	// set to determine if the variable set is relevant. If it is nil, the
+	// variable set is relevant if the value is "truthy".
+	Predicate func(subject variable.Spec, v any) bool
+
+	// PredicateType is the type of the value that Predicate accepts.
+	PredicateType reflect.Type
+
+	// Describe, if non-nil, returns a human-readable description of the
+	// condition, as it applies to the given variable.
+	Describe func(variable.Spec) string
+}
+
+// WhenFunc is an option for RelevantIf() that enables the variable set only if
+// the value obtained from the other set satisfies the given predicate.
+//
+// T must be the type of the value produced by the other set, otherwise
+// RelevantIf() panics. Use WithConditionDescription() to describe the condition
+// in the generated documentation.
+func WhenFunc[T any](predicate func(T) bool) RelevantIfOption {
+	return option{
+		ApplyToRelevantIfConfig: func(cfg *relevantIfConfig) {
+			cfg.Predicate = typedPredicate("RelevantIf", predicate)
+			cfg.PredicateType = reflectx.TypeOf[T]()
+			cfg.Describe = nil
+		},
+	}
+}
+
+// WhenEqual is an option for RelevantIf() that enables the variable set only
+// if the value obtained from the other set is equal to v.
+//
+// T must be the type of the value produced by the other set, otherwise
+// RelevantIf() panics. Untyped constants must be converted explicitly when the
+// set does not produce their default type, such as WhenEqual(uint16(8080)).
+func WhenEqual[T comparable](v T) RelevantIfOption {
+	return option{
+		ApplyToRelevantIfConfig: func(cfg *relevantIfConfig) {
+			cfg.Predicate = typedPredicate(
+				"RelevantIf",
+				func(x T) bool {
+					return x == v
+				},
+			)
+			cfg.PredicateType = reflectx.TypeOf[T]()
+
+			cfg.Describe = func(s variable.Spec) string {
+				if s, ok := s.(interface {
+					Marshal(T) (variable.Literal, error)
+				}); ok {
+					if lit, err := s.Marshal(v); err == nil {
+						return fmt.Sprintf("is `%s`", lit.String)
+					}
+				}
+
+				return fmt.Sprintf("is `%v`", v)
+			}
+		},
+	}
+}
+
+// typedPredicate returns a predicate that calls fn with a value obtained from
+// a variable set.
+//
+// The returned predicate panics if the value is not of type T. option is the
+// name of the option that uses the predicate, and subject is the variable that
+// the option applies to, both of which are used in the panic message.
+func typedPredicate[T any](
+	option string,
+	fn func(T) bool,
+) func(subject variable.Spec, v any) bool {
+	return func(subject variable.Spec, v any) bool {
+		n, ok := v.(T)
+		if !ok {
+			panic(predicateTypeMismatch(option, subject, reflect.TypeOf(v), reflectx.TypeOf[T]()))
+		}
+
+		return fn(n)
+	}
+}
+
+// checkPredicateType panics if a predicate that accepts values of type t can
+// not be called with the values produced by s.
+//
+// It allows the mismatch to be reported when the option is applied, rather
+// than when the condition is first evaluated. Sets that produce interface
+// values are checked by the predicate itself, as the type of each value is
+// not known in advance.
+func checkPredicateType(
+	option string,
+	subject variable.Spec,
+	s VariableSet,
+	t reflect.Type,
+) {
+	vt := s.valueType()
+	if vt.Kind() == reflect.Interface || vt.AssignableTo(t) {
+		return
+	}
+
+	panic(predicateTypeMismatch(option, subject, vt, t))
+}
+
+// predicateTypeMismatch returns the message used when a predicate that accepts
+// values of type t is used with a variable set that produces values of type
+// vt.
+func predicateTypeMismatch(
+	option string,
+	subject variable.Spec,
+	vt, t reflect.Type,
+) string {
+	return fmt.Sprintf(
+		"cannot evaluate the %s() condition of %s, expected a predicate that accepts %s, got func(%s) bool",
+		option,
+		subject.Name(),
+		vt,
+		t,
+	)
 }
@@ -0,0 +1,185 @@
+package ferrite_test
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("func RelevantIf()", func() {
+	AfterEach(func() {
+		tearDown()
+	})
+
+	It("treats the variable as irrelevant if the other value is unavailable", func() {
+		os.Setenv("FERRITE_STRING", "<value>")
+
+		enabled := Bool("FERRITE_ENABLED", "<desc>").
+			Optional()
+
+		v := String("FERRITE_STRING", "<desc>").
+			Optional(RelevantIf(enabled))
+
+		_, ok := v.Value()
+		Expect(ok).To(BeFalse())
+	})
+
+	Describe("func WhenEqual()", func() {
+		var storage Required[string]
+
+		BeforeEach(func() {
+			os.Setenv("FERRITE_STRING", "<value>")
+
+			storage = Enum("FERRITE_STORAGE", "<desc>").
+				WithMembers("disk", "s3").
+				WithDefault("disk").
+				Required()
+		})
+
+		It("treats the variable as relevant if the other value is equal", func() {
+			os.Setenv("FERRITE_STORAGE", "s3")
+
+			v := String("FERRITE_STRING", "<desc>").
+				Optional(RelevantIf(storage, WhenEqual("s3")))
+
+			x, ok := v.Value()
+			Expect(ok).To(BeTrue())
+			Expect(x).To(Equal("<value>"))
+		})
+
+		It("treats the variable as irrelevant if the other value is not equal", func() {
+			v := String("FERRITE_STRING", "<desc>").
+				Optional(RelevantIf(storage, WhenEqual("s3")))
+
+			_, ok := v.Value()
+			Expect(ok).To(BeFalse())
+		})
+
+		It("panics if the value does not have the same type as the other value", func() {
+			Expect(func() {
+				String("FERRITE_STRING", "<desc>").
+					Optional(RelevantIf(storage, WhenEqual(123)))
+			}).To(PanicWith("cannot evaluate the RelevantIf() condition of FERRITE_STRING, expected a predicate that accepts string, got func(int) bool"))
+		})
+
+		It("panics if the type of an untyped constant is inferred incorrectly", func() {
+			port := Unsigned[uint16]("FERRITE_PORT", "<desc>").
+				Required()
+
+			Expect(func() {
+				String("FERRITE_STRING", "<desc>").
+					Optional(RelevantIf(port, WhenEqual(8080)))
+			}).To(PanicWith("cannot evaluate the RelevantIf() condition of FERRITE_STRING, expected a predicate that accepts uint16, got func(int) bool"))
+		})
+	})
+
+	Describe("func WhenFunc()", func() {
+		var port Required[uint16]
+
+		BeforeEach(func() {
+			os.Setenv("FERRITE_STRING", "<value>")
+
+			port = Unsigned[uint16]("FERRITE_PORT", "<desc>").
+				WithDefault(80).
+				Required()
+		})
+
+		It("treats the variable as relevant if the predicate is satisfied", func() {
+			os.Setenv("FERRITE_PORT", "8080")
+
+			v := String("FERRITE_STRING", "<desc>").
+				Optional(
+					RelevantIf(
+						port,
+						WhenFunc(func(p uint16) bool { return p > 1024 }),
+					),
+				)
+
+			x, ok := v.Value()
+			Expect(ok).To(BeTrue())
+			Expect(x).To(Equal("<value>"))
+		})
+
+		It("treats the variable as irrelevant if the predicate is not satisfied", func() {
+			v := String("FERRITE_STRING", "<desc>").
+				Optional(
+					RelevantIf(
+						port,
+						WhenFunc(func(p uint16) bool { return p > 1024 }),
+					),
+				)
+
+			_, ok := v.Value()
+			Expect(ok).To(BeFalse())
+		})
+
+		It("panics if the predicate does not accept the type of the other value", func() {
+			Expect(func() {
+				String("FERRITE_STRING", "<desc>").
+					Optional(
+						RelevantIf(
+							port,
+							WhenFunc(func(p int) bool { return p > 1024 }),
+						),
+					)
+			}).To(PanicWith("cannot evaluate the RelevantIf() condition of FERRITE_STRING, expected a predicate that accepts uint16, got func(int) bool"))
+		})
+	})
+})
+
+func ExampleWhenEqual() {
+	defer example()()
+
+	storage := ferrite.
+		Enum("FERRITE_STORAGE", "the storage backend").
+		WithMembers("disk", "s3").
+		Required()
+
+	bucket := ferrite.
+		String("FERRITE_S3_BUCKET", "the name of the S3 bucket").
+		Required(
+			ferrite.RelevantIf(storage, ferrite.WhenEqual("s3")),
+		)
+
+	os.Setenv("FERRITE_STORAGE", "s3")
+	os.Setenv("FERRITE_S3_BUCKET", "my-bucket")
+	ferrite.Init()
+
+	fmt.Println("bucket is", bucket.Value())
+
+	// Output:
+	// bucket is my-bucket
+}
+
+func ExampleWhenFunc() {
+	defer example()()
+
+	port := ferrite.
+		NetworkPort("FERRITE_PORT", "the port to listen on").
+		Required()
+
+	ferrite.
+		Bool("FERRITE_PRIVILEGED", "run with elevated privileges").
+		Required(
+			ferrite.RelevantIf(
+				port,
+				ferrite.WhenFunc(
+					func(p string) bool {
+						return p == "http" || p == "https"
+					},
+				),
+				ferrite.WithConditionDescription("is a well-known HTTP port"),
+			),
+		)
+
+	// FERRITE_PRIVILEGED is "required" but it is not relevant, and may be
+	// left undefined, because FERRITE_PORT is not a well-known HTTP port.
+	os.Setenv("FERRITE_PORT", "8080")
+	ferrite.Init()
+
+	// Output:
+}
@@ -0,0 +1,78 @@
+package ferrite
+
+import (
+	"github.com/dogmatiq/ferrite/internal/reflectx"
+	"github.com/dogmatiq/ferrite/internal/variable"
+)
+
+// RequiredIf is an option for an optional variable set that causes it to
+// behave as though it were required when the value obtained from another set,
+// s, satisfies the given predicate.
+//
+// The predicate is not called if the value of s is unavailable, in which case
+// the variable set remains optional.
+//
+// T must be the type of the value produced by s, otherwise RequiredIf() panics.
+func RequiredIf[T any](
+	s VariableSet,
+	predicate func(T) bool,
+	options ...RequiredIfOption,
+) OptionalOption {
+	return option{
+		ApplyToSpecInOptionalSet: func(b variable.SpecBuilder) {
+			subject := b.Peek()
+			checkPredicateType("RequiredIf", subject, s, reflectx.TypeOf[T]())
+
+			for _, v := range s.variables() {
+				rel := variable.RequiredIf{
+					Subject:    subject,
+					RequiredIf: v.Spec(),
+				}
+
+				for _, opt := range options {
+					opt.applyRequiredIfOption(&rel)
+				}
+
+				variable.EstablishRelationships(rel)
+			}
+
+			pred := typedPredicate("RequiredIf", predicate)
+
+			b.Requirement(
+				func(sc *variable.Scope) bool {
+					v := s.value(sc)
+					if v == nil {
+						return false
+					}
+
+					return pred(subject, v)
+				},
+			)
+		},
+	}
+}
+
+// RequiredIfOption changes the behavior of the RequiredIf() option.
+type RequiredIfOption interface {
+	applyRequiredIfOption(*variable.RequiredIf)
+}
+
+// WithConditionDescription is an option for RequiredIf() and RelevantIf() that
+// provides a human-readable description of the condition that the value of the
+// other variable set must satisfy.
+//
+// The description is used in the generated documentation. It must complete the
+// phrase "when <variable>...", for example "is `manual`".
+func WithConditionDescription(desc string) interface {
+	RequiredIfOption
+	RelevantIfOption
+} {
+	return option{
+		ApplyToRequiredIfRelationship: func(r *variable.RequiredIf) {
+			r.Condition = desc
+		},
+		ApplyToDependsOnRelationship: func(r *variable.DependsOn) {
+			r.Condition = desc
+		},
+	}
+}
@@ -0,0 +1,149 @@
+package ferrite_test
+
+import (
+	"os"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("func RequiredIf()", func() {
+	var setup Required[string]
+
+	BeforeEach(func() {
+		setup = Enum("FERRITE_SETUP", "<desc>").
+			WithMembers("auto", "manual").
+			WithDefault("auto").
+			Required()
+	})
+
+	AfterEach(func() {
+		tearDown()
+	})
+
+	It("does not require the variable when the predicate is not satisfied", func() {
+		v := String("FERRITE_STRING", "<desc>").
+			Optional(
+				RequiredIf(
+					setup,
+					func(m string) bool { return m == "manual" },
+				),
+			)
+
+		_, ok := v.Value()
+		Expect(ok).To(BeFalse())
+	})
+
+	It("requires the variable when the predicate is satisfied", func() {
+		os.Setenv("FERRITE_SETUP", "manual")
+
+		v := String("FERRITE_STRING", "<desc>").
+			Optional(
+				RequiredIf(
+					setup,
+					func(m string) bool { return m == "manual" },
+				),
+			)
+
+		Expect(func() {
+			v.Value()
+		}).To(PanicWith("FERRITE_STRING is undefined and does not have a default value"))
+	})
+
+	It("uses the value when the predicate is satisfied and the variable is defined", func() {
+		os.Setenv("FERRITE_SETUP", "manual")
+		os.Setenv("FERRITE_STRING", "<value>")
+
+		v := String("FERRITE_STRING", "<desc>").
+			Optional(
+				RequiredIf(
+					setup,
+					func(m string) bool { return m == "manual" },
+				),
+			)
+
+		x, ok := v.Value()
+		Expect(ok).To(BeTrue())
+		Expect(x).To(Equal("<value>"))
+	})
+
+	It("uses the default value when the predicate is satisfied", func() {
+		os.Setenv("FERRITE_SETUP", "manual")
+
+		v := String("FERRITE_STRING", "<desc>").
+			WithDefault("<default>").
+			Optional(
+				RequiredIf(
+					setup,
+					func(m string) bool { return m == "manual" },
+				),
+			)
+
+		x, ok := v.Value()
+		Expect(ok).To(BeTrue())
+		Expect(x).To(Equal("<default>"))
+	})
+
+	It("does not call the predicate when the other value is unavailable", func() {
+		other := String("FERRITE_OTHER", "<desc>").
+			Optional()
+
+		v := String("FERRITE_STRING", "<desc>").
+			Optional(
+				RequiredIf(
+					other,
+					func(string) bool {
+						Fail("unexpected call")
+						return true
+					},
+				),
+			)
+
+		_, ok := v.Value()
+		Expect(ok).To(BeFalse())
+	})
+
+	It("panics if the predicate does not accept the type of the other value", func() {
+		Expect(func() {
+			String("FERRITE_STRING", "<desc>").
+				Optional(
+					RequiredIf(
+						setup,
+						func(int) bool { return true },
+					),
+				)
+		}).To(PanicWith("cannot evaluate the RequiredIf() condition of FERRITE_STRING, expected a predicate that accepts string, got func(int) bool"))
+	})
+})
+
+func ExampleRequiredIf() {
+	defer example()()
+
+	mode := ferrite.
+		Enum("FERRITE_TLS_MODE", "example TLS mode").
+		WithMembers("auto", "manual").
+		Required()
+
+	ferrite.
+		String("FERRITE_TLS_CERT", "example TLS certificate").
+		Optional(
+			ferrite.RequiredIf(
+				mode,
+				func(m string) bool { return m == "manual" },
+				ferrite.WithConditionDescription("is manual"),
+			),
+		)
+
+	os.Setenv("FERRITE_TLS_MODE", "manual")
+	ferrite.Init()
+
+	// Output:
+	// Environment Variables:
+	//
+	//  ❯ FERRITE_TLS_CERT  example TLS certificate  [ <string> ]       ✗ undefined, required when FERRITE_TLS_MODE is manual
+	//    FERRITE_TLS_MODE  example TLS mode           auto | manual    ✓ set to manual
+	//
+	// <process exited with error code 1>
+}
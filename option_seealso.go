@@ -6,6 +6,7 @@ import "github.com/dogmatiq/ferrite/internal/variable"
 // set, s, to the "see also" section of the generated documentation.
 func SeeAlso(s VariableSet, options ...SeeAlsoOption) interface {
 	RequiredOption
+	StructOption
 	OptionalOption
 	DeprecatedOption
 } {
@@ -1,10 +1,21 @@
 package ferrite
 
-import "github.com/dogmatiq/ferrite/internal/variable"
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/dogmatiq/ferrite/internal/reflectx"
+	"github.com/dogmatiq/ferrite/internal/variable"
+)
 
 // SupersededBy is a option for a deprecated variable set that indicates the
 // variables in another set, s, should be used instead.
 func SupersededBy(s VariableSet, options ...SupersededByOption) DeprecatedOption {
+	var cfg supersededByConfig
+	for _, opt := range options {
+		opt.applySupersededByOption(&cfg)
+	}
+
 	return option{
 		ApplyToSpecInDeprecatedSet: func(b variable.SpecBuilder) {
 			for _, v := range s.variables() {
@@ -16,10 +27,116 @@ func SupersededBy(s VariableSet, options ...SupersededByOption) DeprecatedOption
 				)
 			}
 		},
+		ApplyToDeprecatedSetConfig: func(c *variableSetConfig) {
+			if cfg.Fallback != nil {
+				c.Registered = append(
+					c.Registered,
+					func(vars []variable.Any) {
+						cfg.Fallback(s.variables(), vars)
+					},
+				)
+			}
+		},
 	}
 }
 
 // SupersededByOption changes the behavior of the SupersededBy() option.
 type SupersededByOption interface {
-	future()
+	applySupersededByOption(*supersededByConfig)
+}
+
+// supersededByConfig is the configuration for the SupersededBy() option, built
+// from SupersededByOption values.
+type supersededByConfig struct {
+	// Fallback, if non-nil, is called with the variables in the superseding
+	// set and the deprecated set, once the deprecated set is registered.
+	Fallback func(superseding, deprecated []variable.Any)
+}
+
+// WithFallback is an option for SupersededBy() that causes the superseding
+// variable to use the value of the deprecated variable if the superseding
+// variable is undefined.
+//
+// The deprecated variable's value is only used if it is explicitly defined and
+// valid. Both variables must have the same type; use WithFallbackFunc() to
+// convert between types.
+func WithFallback() SupersededByOption {
+	return option{
+		ApplyToSupersededByConfig: func(cfg *supersededByConfig) {
+			cfg.Fallback = func(superseding, deprecated []variable.Any) {
+				dst, src := fallbackPair("WithFallback", superseding, deprecated)
+
+				dstType := dst.Spec().Schema().Type()
+				srcType := src.Spec().Schema().Type()
+
+				if dstType != srcType {
+					panic(fmt.Sprintf(
+						"cannot use %s as a fallback for %s, %s is not the same type as %s, use WithFallbackFunc() instead",
+						src.Spec().Name(),
+						dst.Spec().Name(),
+						srcType,
+						dstType,
+					))
+				}
+
+				variable.AddFallback(dst, src, nil)
+			}
+		},
+	}
+}
+
+// WithFallbackFunc is an option for SupersededBy() that causes the superseding
+// variable to use the value of the deprecated variable if the superseding
+// variable is undefined, converting the deprecated variable's value of type D
+// to the superseding variable's type T using fn.
+//
+// The deprecated variable's value is only used if it is explicitly defined and
+// valid. The converted value must meet the superseding variable's
+// requirements.
+func WithFallbackFunc[D, T any](fn func(D) T) SupersededByOption {
+	return option{
+		ApplyToSupersededByConfig: func(cfg *supersededByConfig) {
+			cfg.Fallback = func(superseding, deprecated []variable.Any) {
+				dst, src := fallbackPair("WithFallbackFunc", superseding, deprecated)
+
+				checkFallbackType(dst, reflectx.TypeOf[T]())
+				checkFallbackType(src, reflectx.TypeOf[D]())
+
+				variable.AddFallback(
+					dst,
+					src,
+					func(v any) any {
+						return fn(v.(D))
+					},
+				)
+			}
+		},
+	}
+}
+
+// fallbackPair returns the superseding and deprecated variables to use with
+// the named fallback option.
+//
+// It panics if either set does not contain exactly one variable.
+func fallbackPair(option string, superseding, deprecated []variable.Any) (dst, src variable.Any) {
+	if len(superseding) != 1 || len(deprecated) != 1 {
+		panic(fmt.Sprintf(
+			"%s() can only be used with variable sets that contain a single variable",
+			option,
+		))
+	}
+
+	return superseding[0], deprecated[0]
+}
+
+// checkFallbackType panics if v's native type is not t.
+func checkFallbackType(v variable.Any, t reflect.Type) {
+	if actual := v.Spec().Schema().Type(); actual != t {
+		panic(fmt.Sprintf(
+			"cannot use WithFallbackFunc() with %s, expected a function that uses %s, got %s",
+			v.Spec().Name(),
+			actual,
+			t,
+		))
+	}
 }
@@ -0,0 +1,230 @@
+package ferrite_test
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("func WithFallback()", func() {
+	AfterEach(func() {
+		tearDown()
+	})
+
+	It("uses the value of the superseding variable if it is defined", func() {
+		os.Setenv("FERRITE_STRING", "<value>")
+		os.Setenv("FERRITE_STRING_OLD", "<old>")
+
+		v := String("FERRITE_STRING", "<desc>").
+			Required()
+
+		String("FERRITE_STRING_OLD", "<desc>").
+			Deprecated(SupersededBy(v, WithFallback()))
+
+		Expect(v.Value()).To(Equal("<value>"))
+	})
+
+	It("uses the value of the deprecated variable if the superseding variable is undefined", func() {
+		os.Setenv("FERRITE_STRING_OLD", "<old>")
+
+		v := String("FERRITE_STRING", "<desc>").
+			Required()
+
+		String("FERRITE_STRING_OLD", "<desc>").
+			Deprecated(SupersededBy(v, WithFallback()))
+
+		Expect(v.Value()).To(Equal("<old>"))
+	})
+
+	It("prefers the deprecated variable's value over the superseding variable's default value", func() {
+		os.Setenv("FERRITE_STRING_OLD", "<old>")
+
+		v := String("FERRITE_STRING", "<desc>").
+			WithDefault("<default>").
+			Required()
+
+		String("FERRITE_STRING_OLD", "<desc>").
+			Deprecated(SupersededBy(v, WithFallback()))
+
+		Expect(v.Value()).To(Equal("<old>"))
+	})
+
+	It("does not use the default value of the deprecated variable", func() {
+		v := String("FERRITE_STRING", "<desc>").
+			Optional()
+
+		String("FERRITE_STRING_OLD", "<desc>").
+			WithDefault("<default>").
+			Deprecated(SupersededBy(v, WithFallback()))
+
+		_, ok := v.Value()
+		Expect(ok).To(BeFalse())
+	})
+
+	It("does not use the deprecated variable's value if it is invalid", func() {
+		os.Setenv("FERRITE_NUM_OLD", "<invalid>")
+
+		v := Signed[int]("FERRITE_NUM", "<desc>").
+			Optional()
+
+		Signed[int]("FERRITE_NUM_OLD", "<desc>").
+			Deprecated(SupersededBy(v, WithFallback()))
+
+		_, ok := v.Value()
+		Expect(ok).To(BeFalse())
+	})
+
+	It("does not use the deprecated variable's value unless the option is used", func() {
+		os.Setenv("FERRITE_STRING_OLD", "<old>")
+
+		v := String("FERRITE_STRING", "<desc>").
+			Optional()
+
+		String("FERRITE_STRING_OLD", "<desc>").
+			Deprecated(SupersededBy(v))
+
+		_, ok := v.Value()
+		Expect(ok).To(BeFalse())
+	})
+
+	It("reports the deprecated variable that the value was taken from", func() {
+		os.Setenv("FERRITE_STRING_OLD", "<old>")
+
+		v := String("FERRITE_STRING", "<desc>").
+			Required()
+
+		String("FERRITE_STRING_OLD", "<desc>").
+			Deprecated(SupersededBy(v, WithFallback()))
+
+		report, err := Validate()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(report.Variables).To(HaveLen(2))
+		Expect(report.Variables[0].Name).To(Equal("FERRITE_STRING"))
+		Expect(report.Variables[0].Fallback).To(Equal("FERRITE_STRING_OLD"))
+	})
+
+	It("panics if the variables have different types", func() {
+		v := String("FERRITE_STRING", "<desc>").
+			Required()
+
+		Expect(func() {
+			Signed[int]("FERRITE_NUM_OLD", "<desc>").
+				Deprecated(SupersededBy(v, WithFallback()))
+		}).To(PanicWith("cannot use FERRITE_NUM_OLD as a fallback for FERRITE_STRING, int is not the same type as string, use WithFallbackFunc() instead"))
+	})
+
+	It("panics if either set contains more than one variable", func() {
+		v := String("FERRITE_STRING", "<desc>").
+			Required()
+
+		Expect(func() {
+			KubernetesService("ferrite-svc").
+				Deprecated(SupersededBy(v, WithFallback()))
+		}).To(PanicWith("WithFallback() can only be used with variable sets that contain a single variable"))
+	})
+})
+
+var _ = Describe("func WithFallbackFunc()", func() {
+	AfterEach(func() {
+		tearDown()
+	})
+
+	It("converts the value of the deprecated variable", func() {
+		os.Setenv("FERRITE_SECONDS_OLD", "30")
+
+		v := Duration("FERRITE_DURATION", "<desc>").
+			Required()
+
+		Unsigned[uint]("FERRITE_SECONDS_OLD", "<desc>").
+			Deprecated(
+				SupersededBy(
+					v,
+					WithFallbackFunc(func(n uint) time.Duration {
+						return time.Duration(n) * time.Second
+					}),
+				),
+			)
+
+		Expect(v.Value()).To(Equal(30 * time.Second))
+	})
+
+	It("validates the converted value", func() {
+		os.Setenv("FERRITE_NUM_OLD", "-5")
+
+		v := Unsigned[uint]("FERRITE_NUM", "<desc>").
+			WithMaximum(10).
+			Required()
+
+		Signed[int]("FERRITE_NUM_OLD", "<desc>").
+			Deprecated(
+				SupersededBy(
+					v,
+					WithFallbackFunc(func(n int) uint {
+						return uint(-n) * 10
+					}),
+				),
+			)
+
+		Expect(func() {
+			v.Value()
+		}).To(PanicWith("value of FERRITE_NUM (-5) is invalid: too high, expected 10 or less"))
+	})
+
+	It("panics if the function's types do not match the variables", func() {
+		v := String("FERRITE_STRING", "<desc>").
+			Required()
+
+		Expect(func() {
+			Signed[int]("FERRITE_NUM_OLD", "<desc>").
+				Deprecated(
+					SupersededBy(
+						v,
+						WithFallbackFunc(strconv.Itoa),
+					),
+				)
+		}).NotTo(Panic())
+
+		Expect(func() {
+			Unsigned[uint]("FERRITE_UINT_OLD", "<desc>").
+				Deprecated(
+					SupersededBy(
+						v,
+						WithFallbackFunc(strconv.Itoa),
+					),
+				)
+		}).To(PanicWith("cannot use WithFallbackFunc() with FERRITE_UINT_OLD, expected a function that uses uint, got int"))
+	})
+})
+
+func ExampleWithFallback() {
+	defer example()()
+
+	v := ferrite.
+		String("FERRITE_STRING", "example string").
+		Required()
+
+	ferrite.
+		String("FERRITE_STRING_OLD", "example deprecated string").
+		Deprecated(
+			ferrite.SupersededBy(v, ferrite.WithFallback()),
+		)
+
+	os.Setenv("FERRITE_STRING_OLD", "<value>")
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// Environment Variables:
+	//
+	//    FERRITE_STRING      example string               <string>    ✓ set to '<value>', value taken from deprecated FERRITE_STRING_OLD
+	//  ❯ FERRITE_STRING_OLD  example deprecated string  [ <string> ]  ⚠ deprecated variable set to '<value>'
+	//
+	// value is <value>
+}
@@ -0,0 +1,22 @@
+package ferrite
+
+import "github.com/dogmatiq/ferrite/internal/variable"
+
+// WithAlias is an option that adds an alternative name for a variable, such as
+// the name it was known by before it was renamed.
+//
+// If the variable itself is undefined, its value is obtained from its aliases,
+// in the order that they are added. The "validate" mode shows a warning when a
+// value is obtained from an alias. The variable is invalid if it and any of its
+// aliases are defined with different values.
+func WithAlias(name string) interface {
+	RequiredOption
+	OptionalOption
+	DeprecatedOption
+} {
+	return option{
+		ApplyToSpec: func(b variable.SpecBuilder) {
+			b.Alias(name)
+		},
+	}
+}
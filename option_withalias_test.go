@@ -0,0 +1,123 @@
+package ferrite_test
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("func WithAlias()", func() {
+	AfterEach(func() {
+		tearDown()
+	})
+
+	It("uses the value of the variable itself if it is defined", func() {
+		os.Setenv("FERRITE_STRING", "<value>")
+
+		v := String("FERRITE_STRING", "<desc>").
+			Required(WithAlias("FERRITE_STRING_OLD"))
+
+		Expect(v.Value()).To(Equal("<value>"))
+	})
+
+	It("falls back to the alias if the variable itself is undefined", func() {
+		os.Setenv("FERRITE_STRING_OLD", "<value>")
+
+		v := String("FERRITE_STRING", "<desc>").
+			Required(WithAlias("FERRITE_STRING_OLD"))
+
+		Expect(v.Value()).To(Equal("<value>"))
+	})
+
+	It("consults the aliases in order", func() {
+		os.Setenv("FERRITE_STRING_OLDER", "<older>")
+
+		v := String("FERRITE_STRING", "<desc>").
+			Required(
+				WithAlias("FERRITE_STRING_OLD"),
+				WithAlias("FERRITE_STRING_OLDER"),
+			)
+
+		Expect(v.Value()).To(Equal("<older>"))
+	})
+
+	It("uses the default value if neither the variable nor its aliases are defined", func() {
+		v := String("FERRITE_STRING", "<desc>").
+			WithDefault("<default>").
+			Required(WithAlias("FERRITE_STRING_OLD"))
+
+		Expect(v.Value()).To(Equal("<default>"))
+	})
+
+	It("allows the variable and its alias to be defined with the same value", func() {
+		os.Setenv("FERRITE_STRING", "<value>")
+		os.Setenv("FERRITE_STRING_OLD", "<value>")
+
+		v := String("FERRITE_STRING", "<desc>").
+			Required(WithAlias("FERRITE_STRING_OLD"))
+
+		Expect(v.Value()).To(Equal("<value>"))
+	})
+
+	It("panics if the variable and its alias are defined with different values", func() {
+		os.Setenv("FERRITE_STRING", "<value>")
+		os.Setenv("FERRITE_STRING_OLD", "<other>")
+
+		v := String("FERRITE_STRING", "<desc>").
+			Required(WithAlias("FERRITE_STRING_OLD"))
+
+		Expect(func() {
+			v.Value()
+		}).To(PanicWith("value of FERRITE_STRING ('<value>') is invalid: conflicts with the value of FERRITE_STRING_OLD"))
+	})
+
+	It("panics if the alias is the same as the variable name", func() {
+		Expect(func() {
+			String("FERRITE_STRING", "<desc>").
+				Required(WithAlias("FERRITE_STRING"))
+		}).To(PanicWith("specification for FERRITE_STRING is invalid: alias FERRITE_STRING is already used by this variable"))
+	})
+
+	It("panics if the alias is empty", func() {
+		Expect(func() {
+			String("FERRITE_STRING", "<desc>").
+				Required(WithAlias(""))
+		}).To(PanicWith("specification for FERRITE_STRING is invalid: alias must not be empty"))
+	})
+
+	It("reports the alias that was used", func() {
+		os.Setenv("FERRITE_STRING_OLD", "<value>")
+
+		String("FERRITE_STRING", "<desc>").
+			Required(WithAlias("FERRITE_STRING_OLD"))
+
+		report, err := Validate()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(report.Variables).To(HaveLen(1))
+		Expect(report.Variables[0].Alias).To(Equal("FERRITE_STRING_OLD"))
+	})
+})
+
+func ExampleWithAlias() {
+	defer example()()
+
+	v := ferrite.
+		String("FERRITE_STRING", "example string").
+		Required(ferrite.WithAlias("FERRITE_STRING_OLD"))
+
+	os.Setenv("FERRITE_STRING_OLD", "<value>")
+	ferrite.Init()
+
+	fmt.Println("value is", v.Value())
+
+	// Output:
+	// Environment Variables:
+	//
+	//  ❯ FERRITE_STRING  example string    <string>    ⚠ set to '<value>' via deprecated alias FERRITE_STRING_OLD
+	//
+	// value is <value>
+}
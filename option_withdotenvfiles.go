@@ -0,0 +1,22 @@
+package ferrite
+
+// WithDotEnvFiles is an option that loads environment variable values from
+// dotenv files, such as ".env".
+//
+// The files use the same syntax as the output of the "export/dotenv" mode.
+// Values may be quoted, prefixed with the "export" keyword and span multiple
+// lines. Comments beginning with "#" are ignored.
+//
+// Values defined in the environment take precedence over those in the files.
+// If a variable is defined in more than one file, the definition in the
+// earliest file takes precedence. Files that do not exist are ignored.
+//
+// The file and line number from which a value was loaded is shown in the
+// "validate" mode's output.
+func WithDotEnvFiles(files ...string) InitOption {
+	return option{
+		ApplyToInitConfig: func(cfg *initConfig) {
+			cfg.DotEnvFiles = append(cfg.DotEnvFiles, files...)
+		},
+	}
+}
@@ -0,0 +1,88 @@
+package ferrite_test
+
+import (
+	"os"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite"
+	"github.com/dogmatiq/ferrite/internal/mode"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("func WithDotEnvFiles()", func() {
+	AfterEach(func() {
+		tearDown()
+	})
+
+	It("obtains values from the dotenv files", func() {
+		v := String("FERRITE_STRING_MULTILINE", "<desc>").
+			Required()
+
+		Init(WithDotEnvFiles("testdata/example.env"))
+
+		Expect(v.Value()).To(Equal("line 1\nline 2"))
+	})
+
+	It("gives precedence to values in the environment", func() {
+		os.Setenv("FERRITE_STRING", "<environment>")
+
+		v := String("FERRITE_STRING", "<desc>").
+			Required()
+
+		Init(WithDotEnvFiles("testdata/example.env"))
+
+		Expect(v.Value()).To(Equal("<environment>"))
+	})
+
+	It("ignores files that do not exist", func() {
+		v := String("FERRITE_STRING", "<desc>").
+			Required()
+
+		Init(WithDotEnvFiles("testdata/missing.env", "testdata/example.env"))
+
+		Expect(v.Value()).To(Equal("hello, world!"))
+	})
+
+	It("exits with a non-zero status code if a file is malformed", func() {
+		exited := false
+		mode.DefaultConfig.Err = GinkgoWriter
+		mode.DefaultConfig.Exit = func(code int) {
+			Expect(code).NotTo(Equal(0))
+			exited = true
+		}
+
+		Init(WithDotEnvFiles("testdata/malformed.env"))
+
+		Expect(exited).To(BeTrue())
+	})
+})
+
+func ExampleWithDotEnvFiles() {
+	defer example()()
+
+	ferrite.
+		String("FERRITE_STRING", "example string").
+		Required()
+
+	ferrite.
+		Unsigned[uint16]("FERRITE_NUM_UNSIGNED", "example unsigned integer").
+		Required()
+
+	ferrite.Init(
+		// Load values from a ".env.local" file, if present, then from a
+		// ".env" file. Values in ".env.local" take precedence.
+		ferrite.WithDotEnvFiles(
+			"testdata/example.env.local",
+			"testdata/example.env",
+		),
+	)
+
+	// Output:
+	// Environment Variables:
+	//
+	//  ❯ FERRITE_NUM_UNSIGNED  example unsigned integer    <uint16>    ✗ set to 123abc from testdata/example.env:4, expected integer between 0 and 65535
+	//    FERRITE_STRING        example string              <string>    ✓ set to 'hello, world!' from testdata/example.env:3
+	//
+	// <process exited with error code 1>
+}
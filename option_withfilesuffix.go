@@ -0,0 +1,33 @@
+package ferrite
+
+import "github.com/dogmatiq/ferrite/internal/variable"
+
+// WithFileSuffix is an option that allows a variable's value to be read from a
+// file.
+//
+// The path to the file is specified by an environment variable with the same
+// name as the variable, plus a "_FILE" suffix. For example, the value of
+// DB_PASSWORD may be read from the file at the path given by DB_PASSWORD_FILE.
+// This convention is commonly used to supply secrets that are mounted as
+// files, such as Docker and Kubernetes secrets.
+//
+// A single trailing newline is removed from the file's content. The variable is
+// invalid if both the variable itself and its "_FILE" variable are defined.
+//
+// When used as a registry option it applies to every variable in the registry.
+func WithFileSuffix() interface {
+	RegistryOption
+	RequiredOption
+	StructOption
+	OptionalOption
+	DeprecatedOption
+} {
+	return option{
+		ApplyToRegistry: func(reg *variable.Registry) {
+			reg.AllowFiles = true
+		},
+		ApplyToSpec: func(b variable.SpecBuilder) {
+			b.AllowFile()
+		},
+	}
+}
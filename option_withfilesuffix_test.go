@@ -0,0 +1,124 @@
+package ferrite_test
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/dogmatiq/ferrite"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("func WithFileSuffix()", func() {
+	var dir string
+
+	BeforeEach(func() {
+		dir = GinkgoT().TempDir()
+	})
+
+	AfterEach(func() {
+		tearDown()
+	})
+
+	writeFile := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		err := os.WriteFile(path, []byte(content), 0600)
+		Expect(err).ShouldNot(HaveOccurred())
+		return path
+	}
+
+	It("reads the value from the file specified by the _FILE variable", func() {
+		os.Setenv("FERRITE_STRING_FILE", writeFile("secret", "<value>\n"))
+
+		v := String("FERRITE_STRING", "<desc>").
+			Required(WithFileSuffix())
+
+		Expect(v.Value()).To(Equal("<value>"))
+	})
+
+	It("only removes a single trailing newline", func() {
+		os.Setenv("FERRITE_STRING_FILE", writeFile("secret", "<value>\n\n"))
+
+		v := String("FERRITE_STRING", "<desc>").
+			Required(WithFileSuffix())
+
+		Expect(v.Value()).To(Equal("<value>\n"))
+	})
+
+	It("uses the environment variable if the _FILE variable is undefined", func() {
+		os.Setenv("FERRITE_STRING", "<value>")
+
+		v := String("FERRITE_STRING", "<desc>").
+			Required(WithFileSuffix())
+
+		Expect(v.Value()).To(Equal("<value>"))
+	})
+
+	It("uses the default value if the file is empty", func() {
+		os.Setenv("FERRITE_STRING_FILE", writeFile("secret", "\n"))
+
+		v := String("FERRITE_STRING", "<desc>").
+			WithDefault("<default>").
+			Required(WithFileSuffix())
+
+		Expect(v.Value()).To(Equal("<default>"))
+	})
+
+	It("ignores the _FILE variable if the option is not used", func() {
+		os.Setenv("FERRITE_STRING_FILE", writeFile("secret", "<value>"))
+
+		v := String("FERRITE_STRING", "<desc>").
+			Optional()
+
+		_, ok := v.Value()
+		Expect(ok).To(BeFalse())
+	})
+
+	It("validates the content of the file", func() {
+		os.Setenv("FERRITE_NUM_FILE", writeFile("secret", "<value>"))
+
+		v := Unsigned[uint]("FERRITE_NUM", "<desc>").
+			Required(WithFileSuffix())
+
+		Expect(func() {
+			v.Value()
+		}).To(PanicWith("value of FERRITE_NUM ('<value>') is invalid: unrecognized uint syntax"))
+	})
+
+	It("panics if both the variable and the _FILE variable are defined", func() {
+		os.Setenv("FERRITE_STRING", "<value>")
+		os.Setenv("FERRITE_STRING_FILE", writeFile("secret", "<value>"))
+
+		v := String("FERRITE_STRING", "<desc>").
+			Required(WithFileSuffix())
+
+		Expect(func() {
+			v.Value()
+		}).To(PanicWith("value of FERRITE_STRING ('<value>') is invalid: must not be defined at the same time as FERRITE_STRING_FILE"))
+	})
+
+	It("panics if the file can not be read", func() {
+		path := filepath.Join(dir, "missing")
+		os.Setenv("FERRITE_STRING_FILE", path)
+
+		v := String("FERRITE_STRING", "<desc>").
+			Required(WithFileSuffix())
+
+		Expect(func() {
+			v.Value()
+		}).To(PanicWith("unable to read FERRITE_STRING from the file specified by FERRITE_STRING_FILE: open " + path + ": no such file or directory"))
+	})
+
+	When("used as a registry option", func() {
+		It("applies to all variables in the registry", func() {
+			os.Setenv("FERRITE_STRING_FILE", writeFile("secret", "<value>\n"))
+
+			reg := NewRegistry("<key>", "<name>", WithFileSuffix())
+
+			v := String("FERRITE_STRING", "<desc>").
+				Required(WithRegistry(reg))
+
+			Expect(v.Value()).To(Equal("<value>"))
+		})
+	})
+})
@@ -6,6 +6,7 @@ import "github.com/dogmatiq/ferrite/internal/variable"
 func WithRegistry(reg Registry) interface {
 	InitOption
 	RequiredOption
+	StructOption
 	OptionalOption
 	DeprecatedOption
 } {
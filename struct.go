@@ -0,0 +1,402 @@
+package ferrite
+
+import (
+	"fmt"
+	"net/netip"
+	"net/url"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/dogmatiq/ferrite/internal/reflectx"
+	"github.com/dogmatiq/ferrite/internal/variable"
+	"golang.org/x/exp/constraints"
+)
+
+// Struct configures a set of environment variables from the fields of a struct
+// of type T, and returns a variable set that produces a populated T.
+//
+// Each field that has an "env" tag is mapped to an environment variable of
+// that name, using the builder that is appropriate for the field's type. The
+// following tags are supported:
+//
+//   - env: the name of the environment variable
+//   - desc: a human-readable description of the environment variable
+//   - default: the default value, using the same syntax as the variable itself
+//   - min, max: the limits of numeric, duration and time fields
+//   - enum: a comma-separated list of the permitted values of a string field
+//   - sensitive: "true" if the variable contains sensitive content
+//
+// Fields of struct type (other than those with a dedicated builder, such as
+// [time.Time]) are treated as nested configuration structs. If the field has
+// an "env" tag, its value is used as a prefix for the names of the nested
+// variables, separated by an underscore.
+//
+// Fields without an "env" tag are ignored. Every variable is required, unless
+// it has a default value.
+//
+// The options are applied to the variable of each field. Options that only
+// make sense for a single variable, such as [WithAlias] and [RelevantIf], are
+// not accepted.
+func Struct[T any](options ...StructOption) Required[T] {
+	t := reflectx.TypeOf[T]()
+	if t.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("cannot use %s as a configuration struct, expected a struct type", t))
+	}
+
+	var cfg variableSetConfig
+	for _, opt := range options {
+		opt.applyStructOptionToConfig(&cfg)
+	}
+
+	s := &structSet{
+		Config:  cfg,
+		Options: options,
+	}
+	s.addFields(t, nil, "")
+
+	return requiredFunc[T]{
+		s.Variables,
+		func(sc *variable.Scope) (T, error) {
+			var v T
+			rv := reflect.ValueOf(&v).Elem()
+
+			for _, f := range s.Fields {
+				fv, err := f.Value(sc)
+				if err != nil {
+					return v, err
+				}
+
+				dst := rv.FieldByIndex(f.Index)
+				dst.Set(fv.Convert(dst.Type()))
+			}
+
+			return v, nil
+		},
+	}
+}
+
+// StructOption is an option that configures the variable set returned by
+// Struct(). It is applied to the variable of each of the struct's fields.
+type StructOption interface {
+	applyStructOptionToConfig(*variableSetConfig)
+	applyStructOptionToSpec(variable.SpecBuilder)
+}
+
+// structSet is the set of variables produced from the fields of a struct.
+type structSet struct {
+	Config    variableSetConfig
+	Options   []StructOption
+	Variables []variable.Any
+	Fields    []structFieldBinding
+}
+
+// structFieldBinding associates a struct field with the variable that
+// provides its value.
+type structFieldBinding struct {
+	Index []int
+	Value func(*variable.Scope) (reflect.Value, error)
+}
+
+// structField describes a struct field that is mapped to an environment
+// variable.
+type structField struct {
+	reflect.StructField
+
+	Name        string
+	Desc        string
+	Default     string
+	HasDefault  bool
+	Min, Max    string
+	Enum        []string
+	IsSensitive bool
+
+	hasLimits bool
+}
+
+// addFields adds the fields of the struct type t to the set.
+func (s *structSet) addFields(t reflect.Type, index []int, prefix string) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		env, hasEnv := f.Tag.Lookup("env")
+		idx := append(append([]int(nil), index...), i)
+
+		if f.Type.Kind() == reflect.Struct && !hasStructFieldBuilder(f.Type) {
+			if !f.IsExported() && !f.Anonymous {
+				continue
+			}
+
+			p := prefix
+			if hasEnv {
+				p += env + "_"
+			}
+			s.addFields(f.Type, idx, p)
+			continue
+		}
+
+		if !hasEnv {
+			continue
+		}
+
+		if !f.IsExported() {
+			panic(fmt.Sprintf(
+				"cannot use %s.%s as an environment variable, the field is not exported",
+				t,
+				f.Name,
+			))
+		}
+
+		sf := &structField{
+			StructField: f,
+			Name:        prefix + env,
+			Desc:        f.Tag.Get("desc"),
+			Min:         f.Tag.Get("min"),
+			Max:         f.Tag.Get("max"),
+			IsSensitive: f.Tag.Get("sensitive") == "true",
+		}
+		sf.Default, sf.HasDefault = f.Tag.Lookup("default")
+
+		if enum, ok := f.Tag.Lookup("enum"); ok {
+			sf.Enum = strings.Split(enum, ",")
+		}
+
+		s.addField(t, idx, sf)
+	}
+}
+
+// addField adds a single field to the set.
+func (s *structSet) addField(t reflect.Type, index []int, f *structField) {
+	var bind func(*structSet, *structField) structFieldBinding
+
+	if fn, ok := structFieldBuilders[f.Type]; ok {
+		bind = fn
+	} else if f.Enum != nil && f.Type.Kind() == reflect.String {
+		bind = func(s *structSet, f *structField) structFieldBinding {
+			return bindStructField[string](s, f, Enum(f.Name, f.Desc).WithMembers(f.Enum...))
+		}
+	} else if fn, ok := structFieldKindBuilders[f.Type.Kind()]; ok {
+		bind = fn
+	} else if f.Type.Kind() == reflect.Slice && f.Type.Elem().Kind() == reflect.Uint8 {
+		bind = func(s *structSet, f *structField) structFieldBinding {
+			return bindStructField[[]byte](s, f, Binary(f.Name, f.Desc))
+		}
+	} else if f.Type.Kind() == reflect.Slice && f.Type.Elem().Kind() == reflect.String {
+		bind = func(s *structSet, f *structField) structFieldBinding {
+			b := Slice(f.Name, f.Desc)
+			return bindStructField[[]string](
+				s,
+				f,
+				structFieldElement[[]string](
+					func() (variable.TypedSchema[[]string], *variable.TypedSpecBuilder[[]string]) {
+						return b.schema, &b.builder
+					},
+				),
+			)
+		}
+	} else {
+		panic(fmt.Sprintf(
+			"cannot use %s.%s as an environment variable, %s is not a supported type",
+			t,
+			f.StructField.Name,
+			f.Type,
+		))
+	}
+
+	if f.Enum != nil && f.Type.Kind() != reflect.String {
+		panic(fmt.Sprintf(
+			"cannot use %s.%s as an environment variable, the enum tag is only supported on string fields",
+			t,
+			f.StructField.Name,
+		))
+	}
+
+	b := bind(s, f)
+	b.Index = index
+
+	if (f.Min != "" || f.Max != "") && !f.hasLimits {
+		panic(fmt.Sprintf(
+			"cannot use %s.%s as an environment variable, the min and max tags are not supported on %s fields",
+			t,
+			f.StructField.Name,
+			f.Type,
+		))
+	}
+
+	s.Fields = append(s.Fields, b)
+}
+
+// bindStructField completes the build process of the builder for a struct
+// field and registers the resulting variable.
+func bindStructField[T any](
+	s *structSet,
+	f *structField,
+	elem Element[T],
+) structFieldBinding {
+	schema, builder := elem.element()
+
+	if f.IsSensitive {
+		builder.MarkSensitive()
+	}
+
+	if f.HasDefault {
+		v, err := schema.Unmarshal(variable.Literal{String: f.Default})
+		if err != nil {
+			panic(fmt.Sprintf(
+				"specification for %s is invalid: default value: %s",
+				f.Name,
+				err,
+			))
+		}
+		builder.Default(v)
+	}
+
+	builder.MarkRequired()
+	for _, opt := range s.Options {
+		opt.applyStructOptionToSpec(builder)
+	}
+
+	v := variable.Register(
+		s.Config.Registries,
+		builder.Done(schema),
+	)
+
+	s.Variables = append(s.Variables, v)
+
+	return structFieldBinding{
+		Value: func(s *variable.Scope) (reflect.Value, error) {
+			v := v.In(s)
+			if err := v.Error(); err != nil {
+				return reflect.Value{}, err
+			}
+			return reflect.ValueOf(v.NativeValue()), nil
+		},
+	}
+}
+
+// structFieldElement adapts a function to the [Element] interface, for
+// builders that can not be used as elements of other composite variables.
+type structFieldElement[T any] func() (variable.TypedSchema[T], *variable.TypedSpecBuilder[T])
+
+func (fn structFieldElement[T]) element() (variable.TypedSchema[T], *variable.TypedSpecBuilder[T]) {
+	return fn()
+}
+
+// withStructFieldLimits applies the limits specified by the "min" and "max"
+// tags of a struct field, parsing them using m.
+func withStructFieldLimits[T, B any](
+	f *structField,
+	m variable.Marshaler[T],
+	min, max func(T) B,
+) {
+	f.hasLimits = true
+
+	for _, l := range []struct {
+		Tag   string
+		Value string
+		Apply func(T) B
+	}{
+		{"min", f.Min, min},
+		{"max", f.Max, max},
+	} {
+		if l.Value == "" {
+			continue
+		}
+
+		v, err := m.Unmarshal(variable.Literal{String: l.Value})
+		if err != nil {
+			panic(fmt.Sprintf(
+				"specification for %s is invalid: %s tag: %s",
+				f.Name,
+				l.Tag,
+				err,
+			))
+		}
+
+		l.Apply(v)
+	}
+}
+
+func bindSignedStructField[T constraints.Signed](s *structSet, f *structField) structFieldBinding {
+	b := Signed[T](f.Name, f.Desc)
+	withStructFieldLimits[T](f, b.schema, b.WithMinimum, b.WithMaximum)
+	return bindStructField[T](s, f, b)
+}
+
+func bindUnsignedStructField[T constraints.Unsigned](s *structSet, f *structField) structFieldBinding {
+	b := Unsigned[T](f.Name, f.Desc)
+	withStructFieldLimits[T](f, b.schema, b.WithMinimum, b.WithMaximum)
+	return bindStructField[T](s, f, b)
+}
+
+func bindFloatStructField[T constraints.Float](s *structSet, f *structField) structFieldBinding {
+	b := Float[T](f.Name, f.Desc)
+	withStructFieldLimits[T](f, b.schema, b.WithMinimum, b.WithMaximum)
+	return bindStructField[T](s, f, b)
+}
+
+// structFieldKindBuilders is a map of reflect.Kind to the function that binds
+// a field of that kind, for kinds that do not have a more specific builder.
+var structFieldKindBuilders = map[reflect.Kind]func(*structSet, *structField) structFieldBinding{
+	reflect.String: func(s *structSet, f *structField) structFieldBinding {
+		return bindStructField[string](s, f, String(f.Name, f.Desc))
+	},
+	reflect.Bool: func(s *structSet, f *structField) structFieldBinding {
+		return bindStructField[bool](s, f, Bool(f.Name, f.Desc))
+	},
+	reflect.Int:     bindSignedStructField[int],
+	reflect.Int8:    bindSignedStructField[int8],
+	reflect.Int16:   bindSignedStructField[int16],
+	reflect.Int32:   bindSignedStructField[int32],
+	reflect.Int64:   bindSignedStructField[int64],
+	reflect.Uint:    bindUnsignedStructField[uint],
+	reflect.Uint8:   bindUnsignedStructField[uint8],
+	reflect.Uint16:  bindUnsignedStructField[uint16],
+	reflect.Uint32:  bindUnsignedStructField[uint32],
+	reflect.Uint64:  bindUnsignedStructField[uint64],
+	reflect.Float32: bindFloatStructField[float32],
+	reflect.Float64: bindFloatStructField[float64],
+}
+
+// structFieldBuilders is a map of field type to the function that binds a
+// field of that type, for types that have a dedicated builder.
+var structFieldBuilders = map[reflect.Type]func(*structSet, *structField) structFieldBinding{
+	reflectx.TypeOf[time.Duration](): func(s *structSet, f *structField) structFieldBinding {
+		b := Duration(f.Name, f.Desc)
+		withStructFieldLimits[time.Duration](f, b.schema, b.WithMinimum, b.WithMaximum)
+		return bindStructField[time.Duration](s, f, b)
+	},
+	reflectx.TypeOf[time.Time](): func(s *structSet, f *structField) structFieldBinding {
+		b := Time(f.Name, f.Desc)
+		withStructFieldLimits[time.Time](f, b.marshaler, b.WithMinimum, b.WithMaximum)
+		return bindStructField[time.Time](s, f, b)
+	},
+	reflectx.TypeOf[*time.Location](): func(s *structSet, f *structField) structFieldBinding {
+		return bindStructField[*time.Location](s, f, Location(f.Name, f.Desc))
+	},
+	reflectx.TypeOf[*url.URL](): func(s *structSet, f *structField) structFieldBinding {
+		return bindStructField[*url.URL](s, f, URL(f.Name, f.Desc))
+	},
+	reflectx.TypeOf[*regexp.Regexp](): func(s *structSet, f *structField) structFieldBinding {
+		return bindStructField[*regexp.Regexp](s, f, Regexp(f.Name, f.Desc))
+	},
+	reflectx.TypeOf[netip.Addr](): func(s *structSet, f *structField) structFieldBinding {
+		return bindStructField[netip.Addr](s, f, IPAddr(f.Name, f.Desc))
+	},
+	reflectx.TypeOf[netip.Prefix](): func(s *structSet, f *structField) structFieldBinding {
+		return bindStructField[netip.Prefix](s, f, Prefix(f.Name, f.Desc))
+	},
+	reflectx.TypeOf[NetworkAddress](): func(s *structSet, f *structField) structFieldBinding {
+		return bindStructField[NetworkAddress](s, f, Address(f.Name, f.Desc))
+	},
+	reflectx.TypeOf[FileName](): func(s *structSet, f *structField) structFieldBinding {
+		return bindStructField[FileName](s, f, File(f.Name, f.Desc))
+	},
+}
+
+// hasStructFieldBuilder returns true if t has a dedicated builder, even though
+// it is a struct type.
+func hasStructFieldBuilder(t reflect.Type) bool {
+	_, ok := structFieldBuilders[t]
+	return ok
+}
@@ -0,0 +1,184 @@
+package ferrite_test
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type structConfig struct {
+	Name     string        `env:"FERRITE_NAME" desc:"the name of the service"`
+	Timeout  time.Duration `env:"FERRITE_TIMEOUT" desc:"the request timeout" default:"10s" min:"1s"`
+	Workers  int           `env:"FERRITE_WORKERS" desc:"the number of workers" default:"4" min:"1" max:"64"`
+	Stage    structStage   `env:"FERRITE_STAGE" desc:"the deployment stage" enum:"dev,prod" default:"prod"`
+	Endpoint *url.URL      `env:"FERRITE_ENDPOINT" desc:"the upstream endpoint"`
+	Password string        `env:"FERRITE_PASSWORD" desc:"the database password" sensitive:"true"`
+	Database struct {
+		Host string `env:"HOST" desc:"the database host" default:"localhost"`
+		Port uint16 `env:"PORT" desc:"the database port" default:"5432"`
+	} `env:"FERRITE_DB"`
+
+	Ignored string
+}
+
+type structStage string
+
+var _ = Describe("func Struct()", func() {
+	AfterEach(func() {
+		tearDown()
+	})
+
+	It("panics if T is not a struct", func() {
+		Expect(func() {
+			Struct[string]()
+		}).To(PanicWith("cannot use string as a configuration struct, expected a struct type"))
+	})
+
+	It("panics if a field has an unsupported type", func() {
+		type config struct {
+			Value chan int `env:"FERRITE_VALUE" desc:"<desc>"`
+		}
+
+		Expect(func() {
+			Struct[config]()
+		}).To(PanicWith("cannot use ferrite_test.config.Value as an environment variable, chan int is not a supported type"))
+	})
+
+	It("panics if a field is not exported", func() {
+		type config struct {
+			value string `env:"FERRITE_VALUE" desc:"<desc>"`
+		}
+
+		Expect(func() {
+			Struct[config]()
+		}).To(PanicWith("cannot use ferrite_test.config.value as an environment variable, the field is not exported"))
+	})
+
+	It("panics if the min or max tags are used on an unsupported field", func() {
+		type config struct {
+			Value string `env:"FERRITE_VALUE" desc:"<desc>" min:"1"`
+		}
+
+		Expect(func() {
+			Struct[config]()
+		}).To(PanicWith("cannot use ferrite_test.config.Value as an environment variable, the min and max tags are not supported on string fields"))
+	})
+
+	It("panics if the default value is invalid", func() {
+		type config struct {
+			Value int `env:"FERRITE_VALUE" desc:"<desc>" default:"ten"`
+		}
+
+		Expect(func() {
+			Struct[config]()
+		}).To(PanicWith("specification for FERRITE_VALUE is invalid: default value: unrecognized int syntax"))
+	})
+
+	It("panics if a field has no description", func() {
+		type config struct {
+			Value string `env:"FERRITE_VALUE"`
+		}
+
+		Expect(func() {
+			Struct[config]()
+		}).To(PanicWith("specification for FERRITE_VALUE is invalid: variable description must not be empty"))
+	})
+
+	Describe("func Value()", func() {
+		It("returns the populated struct", func() {
+			os.Setenv("FERRITE_NAME", "<name>")
+			os.Setenv("FERRITE_WORKERS", "8")
+			os.Setenv("FERRITE_STAGE", "dev")
+			os.Setenv("FERRITE_ENDPOINT", "https://example.org/api")
+			os.Setenv("FERRITE_PASSWORD", "<password>")
+			os.Setenv("FERRITE_DB_HOST", "db.example.org")
+
+			v := Struct[structConfig]().Value()
+
+			Expect(v.Name).To(Equal("<name>"))
+			Expect(v.Timeout).To(Equal(10 * time.Second))
+			Expect(v.Workers).To(Equal(8))
+			Expect(v.Stage).To(Equal(structStage("dev")))
+			Expect(v.Endpoint.String()).To(Equal("https://example.org/api"))
+			Expect(v.Password).To(Equal("<password>"))
+			Expect(v.Database.Host).To(Equal("db.example.org"))
+			Expect(v.Database.Port).To(Equal(uint16(5432)))
+			Expect(v.Ignored).To(BeEmpty())
+		})
+
+		It("panics if one of the variables is invalid", func() {
+			os.Setenv("FERRITE_NAME", "<name>")
+			os.Setenv("FERRITE_WORKERS", "100")
+			os.Setenv("FERRITE_ENDPOINT", "https://example.org/api")
+			os.Setenv("FERRITE_PASSWORD", "<password>")
+
+			Expect(func() {
+				Struct[structConfig]().Value()
+			}).To(PanicWith("value of FERRITE_WORKERS (100) is invalid: too high, expected between +1 and +64"))
+		})
+
+		It("panics if one of the variables is undefined", func() {
+			os.Setenv("FERRITE_ENDPOINT", "https://example.org/api")
+			os.Setenv("FERRITE_PASSWORD", "<password>")
+
+			Expect(func() {
+				Struct[structConfig]().Value()
+			}).To(PanicWith("FERRITE_NAME is undefined and does not have a default value"))
+		})
+
+		It("applies the options to the variable of each field", func() {
+			dir := GinkgoT().TempDir()
+			writeFile := func(name, content string) string {
+				path := filepath.Join(dir, name)
+				err := os.WriteFile(path, []byte(content), 0600)
+				Expect(err).ShouldNot(HaveOccurred())
+				return path
+			}
+
+			os.Setenv("FERRITE_NAME_FILE", writeFile("name", "<name>\n"))
+			os.Setenv("FERRITE_ENDPOINT", "https://example.org/api")
+			os.Setenv("FERRITE_PASSWORD_FILE", writeFile("password", "<password>\n"))
+
+			v := Struct[structConfig](WithFileSuffix()).Value()
+
+			Expect(v.Name).To(Equal("<name>"))
+			Expect(v.Password).To(Equal("<password>"))
+		})
+	})
+})
+
+func ExampleStruct() {
+	defer example()()
+
+	type config struct {
+		Timeout time.Duration `env:"FERRITE_TIMEOUT" desc:"the request timeout" default:"10s"`
+		Workers int           `env:"FERRITE_WORKERS" desc:"the number of workers" min:"1"`
+		DB      struct {
+			Host string `env:"HOST" desc:"the database host"`
+			Port uint16 `env:"PORT" desc:"the database port" default:"5432"`
+		} `env:"FERRITE_DB"`
+	}
+
+	v := ferrite.Struct[config]()
+
+	os.Setenv("FERRITE_WORKERS", "8")
+	os.Setenv("FERRITE_DB_HOST", "localhost")
+	ferrite.Init()
+
+	cfg := v.Value()
+	fmt.Println("timeout is", cfg.Timeout)
+	fmt.Println("workers is", cfg.Workers)
+	fmt.Println("database is", cfg.DB.Host, cfg.DB.Port)
+
+	// Output:
+	// timeout is 10s
+	// workers is 8
+	// database is localhost 5432
+}
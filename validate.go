@@ -0,0 +1,177 @@
+package ferrite
+
+import (
+	"strings"
+
+	"github.com/dogmatiq/ferrite/internal/variable"
+)
+
+// Validate resolves the values of the environment variables in the selected
+// registries and returns a report describing each one.
+//
+// Unlike [Init], it never renders output or exits the process, regardless of
+// the FERRITE_MODE environment variable. Nor does it change the values that
+// the variables return to the application. It is intended for use by libraries
+// and tests that need to inspect the validation results.
+//
+// The returned error is non-nil if any of the variables are invalid, if any
+// group constraint, such as ExactlyOneOf(), is violated, or if the options could
+// not be applied. The errors of each invalid variable and violated group
+// constraint are available via the error's Errors() method, which can be
+// accessed using the interface{ Errors() []error } type. The error also
+// implements Unwrap() []error, such that errors.Is() and errors.As() inspect
+// each of those errors when built with Go 1.20 or later.
+func Validate(options ...InitOption) (ValidationReport, error) {
+	cfg, err := newInitConfig(options)
+	if err != nil {
+		return ValidationReport{}, err
+	}
+
+	var (
+		report ValidationReport
+		errs   []error
+	)
+
+	// Resolve the variables within a scope of their own, such that the values
+	// that the variables return to the application are left unchanged.
+	scope := variable.NewScope(cfg.Lookup)
+
+	for _, rv := range cfg.ModeConfig.Registries.Variables() {
+		v := variable.In(rv.Any, scope)
+
+		r := VariableReport{
+			Name:         v.Spec().Name(),
+			Registry:     rv.Registry.Key,
+			Availability: v.Availability(),
+			Source:       v.Source(),
+			Alias:        v.Alias(),
+			Fallback:     v.Fallback(),
+		}
+
+		if err := v.Error(); err != nil {
+			r.Error = err
+
+			if r.Availability != AvailabilityIgnored {
+				errs = append(errs, err)
+			}
+		}
+
+		report.Variables = append(report.Variables, r)
+	}
+
+	for _, g := range cfg.ModeConfig.Registries.Groups() {
+		if err := g.Check(scope); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if len(errs) != 0 {
+		return report, joinError{errs}
+	}
+
+	return report, nil
+}
+
+// ValidationReport is the result of validating a set of environment variables.
+type ValidationReport struct {
+	// Variables contains the results for each variable, sorted by name.
+	Variables []VariableReport
+}
+
+// VariableReport is the result of validating a single environment variable.
+type VariableReport struct {
+	// Name is the name of the environment variable.
+	Name string
+
+	// Registry is the key of the registry that contains the variable. It is
+	// empty for variables in the default registry.
+	Registry string
+
+	// Availability describes whether the variable's value is available, and
+	// if not, why not.
+	Availability Availability
+
+	// Source describes where the variable's value was obtained.
+	Source Source
+
+	// Alias is the alias from which the variable's value was obtained. It is
+	// empty if the value was not obtained from one of the variable's aliases.
+	Alias string
+
+	// Fallback is the name of the deprecated variable from which the variable's
+	// value was obtained, as per the WithFallback() and WithFallbackFunc()
+	// options. It is empty if the value was not obtained from a deprecated
+	// variable.
+	Fallback string
+
+	// Error describes a problem with the variable, if any.
+	//
+	// It may be non-nil even if Availability is AvailabilityIgnored, in which
+	// case the problem does not cause validation to fail.
+	Error error
+}
+
+// Availability is an enumeration describing why a variable's value is or is not
+// available.
+type Availability = variable.Availability
+
+const (
+	// AvailabilityNone indicates that the variable's value is not available
+	// because it is undefined and has no default value.
+	AvailabilityNone = variable.AvailabilityNone
+
+	// AvailabilityInvalid indicates that the variable is defined with an
+	// invalid value.
+	AvailabilityInvalid = variable.AvailabilityInvalid
+
+	// AvailabilityIgnored indicates that the variable's value is not made
+	// available to the application, for example because it is not relevant.
+	AvailabilityIgnored = variable.AvailabilityIgnored
+
+	// AvailabilityOK indicates that the variable's value is valid and available
+	// to the application.
+	AvailabilityOK = variable.AvailabilityOK
+)
+
+// Source is an enumeration of the possible sources of a variable's value.
+type Source = variable.Source
+
+const (
+	// SourceNone indicates that there is no value, and hence no source.
+	SourceNone = variable.SourceNone
+
+	// SourceDefault indicates that the value is the variable's default value.
+	SourceDefault = variable.SourceDefault
+
+	// SourceEnvironment indicates that the value was obtained from the
+	// environment.
+	SourceEnvironment = variable.SourceEnvironment
+
+	// SourceFile indicates that the value was read from a file, as per
+	// [WithFileSuffix].
+	SourceFile = variable.SourceFile
+)
+
+// joinError is an error that wraps multiple errors.
+//
+// It behaves like the errors returned by errors.Join() in Go 1.20 and later.
+type joinError struct {
+	errs []error
+}
+
+func (e joinError) Error() string {
+	messages := make([]string, len(e.errs))
+	for i, err := range e.errs {
+		messages[i] = err.Error()
+	}
+	return strings.Join(messages, "\n")
+}
+
+// Errors returns the errors that are wrapped by e.
+func (e joinError) Errors() []error {
+	return e.errs
+}
+
+func (e joinError) Unwrap() []error {
+	return e.errs
+}
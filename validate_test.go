@@ -0,0 +1,206 @@
+package ferrite_test
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/dogmatiq/ferrite"
+	. "github.com/dogmatiq/ferrite"
+	"github.com/dogmatiq/ferrite/internal/mode"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("func Validate()", func() {
+	AfterEach(func() {
+		tearDown()
+	})
+
+	It("returns a report describing each variable", func() {
+		os.Setenv("FERRITE_BOOL", "true")
+		Bool("FERRITE_BOOL", "<desc>").
+			Required()
+
+		String("FERRITE_STRING", "<desc>").
+			WithDefault("<default>").
+			Required()
+
+		Unsigned[uint]("FERRITE_UNSIGNED", "<desc>").
+			Optional()
+
+		report, err := Validate()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(report.Variables).To(Equal([]VariableReport{
+			{
+				Name:         "FERRITE_BOOL",
+				Availability: AvailabilityOK,
+				Source:       SourceEnvironment,
+			},
+			{
+				Name:         "FERRITE_STRING",
+				Availability: AvailabilityOK,
+				Source:       SourceDefault,
+			},
+			{
+				Name:         "FERRITE_UNSIGNED",
+				Availability: AvailabilityNone,
+				Source:       SourceNone,
+			},
+		}))
+	})
+
+	It("returns an error that wraps the error of each invalid variable", func() {
+		os.Setenv("FERRITE_BOOL", "yes")
+		Bool("FERRITE_BOOL", "<desc>").
+			Required()
+
+		String("FERRITE_STRING", "<desc>").
+			Required()
+
+		report, err := Validate()
+		Expect(err).To(MatchError(
+			"value of FERRITE_BOOL (yes) is invalid: expected either true or false\n" +
+				"FERRITE_STRING is undefined and does not have a default value",
+		))
+
+		Expect(report.Variables).To(HaveLen(2))
+		Expect(report.Variables[0].Availability).To(Equal(AvailabilityInvalid))
+		Expect(report.Variables[1].Availability).To(Equal(AvailabilityNone))
+
+		wrapped := err.(interface{ Unwrap() []error }).Unwrap()
+		Expect(wrapped).To(HaveLen(2))
+		Expect(wrapped[0]).To(MatchError(report.Variables[0].Error.Error()))
+		Expect(wrapped[1]).To(MatchError(report.Variables[1].Error.Error()))
+	})
+
+	It("returns an error that provides access to the error of each invalid variable", func() {
+		os.Setenv("FERRITE_BOOL", "yes")
+		Bool("FERRITE_BOOL", "<desc>").
+			Required()
+
+		String("FERRITE_STRING", "<desc>").
+			Required()
+
+		report, err := Validate()
+		Expect(err).To(HaveOccurred())
+
+		errs := err.(interface{ Errors() []error }).Errors()
+		Expect(errs).To(HaveLen(2))
+		Expect(errs[0]).To(MatchError(report.Variables[0].Error.Error()))
+		Expect(errs[1]).To(MatchError(report.Variables[1].Error.Error()))
+	})
+
+	It("does not fail if an ignored variable is invalid", func() {
+		enabled := Bool("FERRITE_ENABLED", "<desc>").
+			WithDefault(false).
+			Required()
+
+		os.Setenv("FERRITE_UNSIGNED", "-1")
+		Unsigned[uint]("FERRITE_UNSIGNED", "<desc>").
+			Optional(RelevantIf(enabled))
+
+		report, err := Validate()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(report.Variables[1].Availability).To(Equal(AvailabilityIgnored))
+		Expect(report.Variables[1].Error).To(HaveOccurred())
+	})
+
+	It("only includes variables in the selected registries", func() {
+		reg := NewRegistry("<key>", "<name>")
+
+		String("FERRITE_STRING", "<desc>").
+			WithDefault("<default>").
+			Required(WithRegistry(reg))
+
+		report, err := Validate(WithRegistry(reg))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(report.Variables).To(Equal([]VariableReport{
+			{
+				Name:         "FERRITE_STRING",
+				Registry:     "<key>",
+				Availability: AvailabilityOK,
+				Source:       SourceDefault,
+			},
+		}))
+	})
+
+	It("does not exit the process or render any output", func() {
+		os.Setenv("FERRITE_MODE", "usage/markdown")
+
+		String("FERRITE_STRING", "<desc>").
+			Required()
+
+		mode.DefaultConfig.Out = nil
+		mode.DefaultConfig.Err = nil
+		mode.DefaultConfig.Exit = func(int) {
+			Fail("unexpected call to exit")
+		}
+
+		_, err := Validate()
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("does not change the values of the variables", func() {
+		os.Setenv("FERRITE_STRING", "<environment>")
+
+		v := String("FERRITE_STRING", "<desc>").
+			Required()
+
+		Expect(v.Value()).To(Equal("<environment>"))
+
+		report, err := Validate(
+			WithLookup(MapLookup(map[string]string{})),
+		)
+		Expect(err).To(MatchError("FERRITE_STRING is undefined and does not have a default value"))
+		Expect(report.Variables[0].Availability).To(Equal(AvailabilityNone))
+
+		Expect(v.Value()).To(Equal("<environment>"))
+	})
+
+	It("evaluates the conditions of dependent variables using the same lookup", func() {
+		enabled := Bool("FERRITE_ENABLED", "<desc>").
+			Required()
+
+		Unsigned[uint]("FERRITE_UNSIGNED", "<desc>").
+			Optional(RelevantIf(enabled))
+
+		_, err := Validate(
+			WithLookup(MapLookup(map[string]string{
+				"FERRITE_ENABLED":  "true",
+				"FERRITE_UNSIGNED": "-1",
+			})),
+		)
+		Expect(err).To(MatchError(`value of FERRITE_UNSIGNED (-1) is invalid: unrecognized uint syntax`))
+	})
+
+	It("returns an error if a dotenv file is malformed", func() {
+		_, err := Validate(WithDotEnvFiles("testdata/malformed.env"))
+		Expect(err).To(MatchError("unable to load dotenv file: testdata/malformed.env:1: expected '=' after FERRITE_STRING"))
+	})
+})
+
+func ExampleValidate() {
+	defer example()()
+
+	ferrite.
+		String("FERRITE_STRING", "example string").
+		Required()
+
+	os.Setenv("FERRITE_BOOL", "true")
+	ferrite.
+		Bool("FERRITE_BOOL", "example bool").
+		Required()
+
+	report, err := ferrite.Validate()
+
+	for _, v := range report.Variables {
+		fmt.Println(v.Name, v.Availability == ferrite.AvailabilityOK)
+	}
+
+	fmt.Println(err)
+
+	// Output:
+	// FERRITE_BOOL true
+	// FERRITE_STRING false
+	// FERRITE_STRING is undefined and does not have a default value
+}
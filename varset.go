@@ -1,6 +1,8 @@
 package ferrite
 
 import (
+	"reflect"
+
 	"github.com/dogmatiq/ferrite/internal/variable"
 )
 
@@ -14,10 +16,27 @@ import (
 // builders produce sets containing multiple variables.
 type VariableSet interface {
 	variables() []variable.Any
-	value() any
+
+	// value returns the set's value as it is resolved within s, or nil if it
+	// has no value. If s is nil, the variables' own values are used.
+	value(s *variable.Scope) any
+
+	// valueType returns the type of the values produced by the set.
+	valueType() reflect.Type
 }
 
 // variableSetConfig encapsulates configuration common to all variable sets.
 type variableSetConfig struct {
 	Registries []*variable.Registry
+
+	// Registered is a list of functions that are called with the variables in
+	// the set after they have been registered.
+	Registered []func([]variable.Any)
+}
+
+// registered calls the functions in cfg.Registered with the given variables.
+func (cfg variableSetConfig) registered(vars ...variable.Any) {
+	for _, fn := range cfg.Registered {
+		fn(vars)
+	}
 }
@@ -1,6 +1,9 @@
 package ferrite
 
 import (
+	"reflect"
+
+	"github.com/dogmatiq/ferrite/internal/reflectx"
 	"github.com/dogmatiq/ferrite/internal/variable"
 )
 
@@ -47,9 +50,12 @@ func deprecated[T any, Schema variable.TypedSchema[T]](
 		b.Done(s),
 	)
 
+	cfg.registered(v)
+
 	return deprecatedFunc[T]{
 		[]variable.Any{v},
-		func() (T, bool, error) {
+		func(s *variable.Scope) (T, bool, error) {
+			v := v.In(s)
 			return v.NativeValue(),
 				v.Availability() == variable.AvailabilityOK,
 				v.Error()
@@ -61,19 +67,19 @@ func deprecated[T any, Schema variable.TypedSchema[T]](
 // from an arbitrary function.
 type deprecatedFunc[T any] struct {
 	vars []variable.Any
-	fn   func() (T, bool, error)
+	fn   func(*variable.Scope) (T, bool, error)
 }
 
 func (s deprecatedFunc[T]) DeprecatedValue() (T, bool) {
-	n, ok, err := s.fn()
+	n, ok, err := s.fn(nil)
 	if err != nil {
 		panic(err.Error())
 	}
 	return n, ok
 }
 
-func (s deprecatedFunc[T]) value() any {
-	if n, ok, _ := s.fn(); ok {
+func (s deprecatedFunc[T]) value(sc *variable.Scope) any {
+	if n, ok, _ := s.fn(sc); ok {
 		return n
 	}
 	return nil
@@ -82,3 +88,7 @@ func (s deprecatedFunc[T]) value() any {
 func (s deprecatedFunc[T]) variables() []variable.Any {
 	return s.vars
 }
+
+func (s deprecatedFunc[T]) valueType() reflect.Type {
+	return reflectx.TypeOf[T]()
+}
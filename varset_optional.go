@@ -1,6 +1,9 @@
 package ferrite
 
 import (
+	"reflect"
+
+	"github.com/dogmatiq/ferrite/internal/reflectx"
 	"github.com/dogmatiq/ferrite/internal/variable"
 )
 
@@ -46,7 +49,8 @@ func optional[T any, Schema variable.TypedSchema[T]](
 
 	return optionalFunc[T]{
 		[]variable.Any{v},
-		func() (T, bool, error) {
+		func(s *variable.Scope) (T, bool, error) {
+			v := v.In(s)
 			return v.NativeValue(),
 				v.Availability() == variable.AvailabilityOK,
 				v.Error()
@@ -58,19 +62,19 @@ func optional[T any, Schema variable.TypedSchema[T]](
 // an arbitrary function.
 type optionalFunc[T any] struct {
 	vars []variable.Any
-	fn   func() (T, bool, error)
+	fn   func(*variable.Scope) (T, bool, error)
 }
 
 func (s optionalFunc[T]) Value() (T, bool) {
-	n, ok, err := s.fn()
+	n, ok, err := s.fn(nil)
 	if err != nil {
 		panic(err.Error())
 	}
 	return n, ok
 }
 
-func (s optionalFunc[T]) value() any {
-	if n, ok, _ := s.fn(); ok {
+func (s optionalFunc[T]) value(sc *variable.Scope) any {
+	if n, ok, _ := s.fn(sc); ok {
 		return n
 	}
 	return nil
@@ -79,3 +83,7 @@ func (s optionalFunc[T]) value() any {
 func (s optionalFunc[T]) variables() []variable.Any {
 	return s.vars
 }
+
+func (s optionalFunc[T]) valueType() reflect.Type {
+	return reflectx.TypeOf[T]()
+}
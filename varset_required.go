@@ -1,6 +1,9 @@
 package ferrite
 
 import (
+	"reflect"
+
+	"github.com/dogmatiq/ferrite/internal/reflectx"
 	"github.com/dogmatiq/ferrite/internal/variable"
 )
 
@@ -46,7 +49,8 @@ func required[T any, Schema variable.TypedSchema[T]](
 
 	return requiredFunc[T]{
 		[]variable.Any{v},
-		func() (T, error) {
+		func(s *variable.Scope) (T, error) {
+			v := v.In(s)
 			return v.NativeValue(), v.Error()
 		},
 	}
@@ -56,19 +60,19 @@ func required[T any, Schema variable.TypedSchema[T]](
 // an arbitrary function.
 type requiredFunc[T any] struct {
 	vars []variable.Any
-	fn   func() (T, error)
+	fn   func(*variable.Scope) (T, error)
 }
 
 func (s requiredFunc[T]) Value() T {
-	n, err := s.fn()
+	n, err := s.fn(nil)
 	if err != nil {
 		panic(err.Error())
 	}
 	return n
 }
 
-func (s requiredFunc[T]) value() any {
-	if n, err := s.fn(); err == nil {
+func (s requiredFunc[T]) value(sc *variable.Scope) any {
+	if n, err := s.fn(sc); err == nil {
 		return n
 	}
 	return nil
@@ -77,3 +81,7 @@ func (s requiredFunc[T]) value() any {
 func (s requiredFunc[T]) variables() []variable.Any {
 	return s.vars
 }
+
+func (s requiredFunc[T]) valueType() reflect.Type {
+	return reflectx.TypeOf[T]()
+}